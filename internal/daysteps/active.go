@@ -0,0 +1,35 @@
+package daysteps
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ActiveMinutes рассчитывает общее количество минут активности, например для сравнения
+// с рекомендацией 150 минут в неделю.
+// Принимает строки в формате "количество_шагов,продолжительность" (например, "5000,30m")
+// и суммирует продолжительность всех записей. Если какие-то записи невалидны,
+// возвращает ошибку с номерами всех таких записей. Для пустого списка возвращает 0.
+func ActiveMinutes(entries []string) (float64, error) {
+	var (
+		total time.Duration
+		errs  []error
+	)
+
+	for i, entry := range entries {
+		_, duration, err := parsePackage(entry)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("entry %d: %w", i, err))
+			continue
+		}
+
+		total += duration
+	}
+
+	if len(errs) > 0 {
+		return 0.0, errors.Join(errs...)
+	}
+
+	return total.Minutes(), nil
+}
@@ -0,0 +1,46 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestActiveMinutes() {
+	tests := []struct {
+		name    string
+		entries []string
+		want    float64
+		wantErr string
+	}{
+		{
+			name:    "несколько записей",
+			entries: []string{"5000,30m", "3000,1h15m", "800,7m30s"},
+			want:    112.5,
+		},
+		{
+			name:    "нет записей",
+			entries: nil,
+			want:    0,
+		},
+		{
+			name:    "невалидные записи",
+			entries: []string{"5000,30m", "5000", "abc,30m"},
+			wantErr: "entry 1: ",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := ActiveMinutes(tt.entries)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(suite.T(), err, tt.wantErr)
+				assert.ErrorContains(suite.T(), err, "entry 2: ")
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package daysteps
+
+import (
+	"fmt"
+
+	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
+)
+
+// CalorieBalance рассчитывает баланс калорий за день: разницу потраченных burned
+// и потреблённых consumed калорий. Положительное значение означает дефицит калорий.
+func CalorieBalance(burned, consumed float64) float64 {
+	return burned - consumed
+}
+
+// DayBalance рассчитывает баланс калорий за день по дневной активности и потреблённым калориям.
+// Принимает:
+//   - data: строка в формате "количество_шагов,продолжительность" (например, "5000,30m")
+//   - weight: вес пользователя в килограммах (должен быть > 0)
+//   - height: рост пользователя в метрах (должен быть > 0)
+//   - consumed: потреблённые калории (должны быть >= 0)
+//
+// Калории, потраченные при ходьбе, рассчитываются так же, как в DayActionInfo.
+// Возвращает баланс по CalorieBalance или ошибку в случае невалидных входных данных.
+func DayBalance(data string, weight, height, consumed float64) (float64, error) {
+	if consumed < 0.0 {
+		return 0.0, fmt.Errorf("consumed calories must not be negative, got: %f", consumed)
+	}
+
+	steps, duration, err := parsePackage(data)
+	if err != nil {
+		return 0.0, err
+	}
+
+	burned, err := spentcalories.WalkingSpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return 0.0, err
+	}
+
+	return CalorieBalance(burned, consumed), nil
+}
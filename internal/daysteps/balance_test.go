@@ -0,0 +1,66 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestCalorieBalance() {
+	assert.InDelta(suite.T(), 150.0, CalorieBalance(2150, 2000), 1e-9)
+	assert.InDelta(suite.T(), -300.0, CalorieBalance(1700, 2000), 1e-9)
+}
+
+func (suite *DayStepsTestSuite) TestDayBalance() {
+	tests := []struct {
+		name     string
+		input    string
+		consumed float64
+		want     float64
+		wantErr  bool
+	}{
+		{
+			name:     "дефицит калорий",
+			input:    "10000,2h",
+			consumed: 200,
+			want:     95.3125,
+		},
+		{
+			name:     "профицит калорий",
+			input:    "10000,2h",
+			consumed: 2000,
+			want:     -1704.6875,
+		},
+		{
+			name:     "без потреблённых калорий",
+			input:    "10000,2h",
+			consumed: 0,
+			want:     295.3125,
+		},
+		{
+			name:     "отрицательные потреблённые калории",
+			input:    "10000,2h",
+			consumed: -1,
+			wantErr:  true,
+		},
+		{
+			name:     "некорректные данные",
+			input:    "10000",
+			consumed: 200,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := DayBalance(tt.input, 75.0, 1.75, tt.consumed)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Zero(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package daysteps
+
+import (
+	"math"
+	"time"
+
+	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
+)
+
+// BucketInfo содержит показатели одного часа дневной активности.
+type BucketInfo struct {
+	HourIndex  int     // номер часа, начиная с нуля.
+	Steps      int     // количество шагов за час.
+	DistanceKm float64 // дистанция за час в километрах.
+	Calories   float64 // количество потраченных калорий за час.
+}
+
+// DayActionBuckets разбивает дневную активность на часовые интервалы для графиков.
+// Принимает те же параметры, что и DayActionInfo.
+// Шаги распределяются равномерно по времени: каждый полный час получает одинаковую долю,
+// а неполный последний час - долю, пропорциональную его продолжительности. Шаги округляются
+// так, чтобы их сумма по интервалам совпадала с общим количеством. Дистанция рассчитывается
+// так же, как в DayActionInfo, а калории распределяются пропорционально шагам.
+// Возвращает интервалы в хронологическом порядке или ошибку в случае невалидных данных.
+func DayActionBuckets(data string, weight, height float64) ([]BucketInfo, error) {
+	steps, duration, err := parsePackage(data)
+	if err != nil {
+		return nil, err
+	}
+
+	calories, err := spentcalories.WalkingSpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	hours := int((duration + time.Hour - 1) / time.Hour)
+	buckets := make([]BucketInfo, hours)
+
+	prev := 0
+	for i := range buckets {
+		end := min(time.Duration(i+1)*time.Hour, duration)
+		cumulative := int(math.Round(float64(steps) * end.Seconds() / duration.Seconds()))
+		bucketSteps := cumulative - prev
+		prev = cumulative
+
+		buckets[i] = BucketInfo{
+			HourIndex:  i,
+			Steps:      bucketSteps,
+			DistanceKm: float64(bucketSteps) * StepLengthM / spentcalories.MInKm,
+			Calories:   calories * float64(bucketSteps) / float64(steps),
+		}
+	}
+
+	return buckets, nil
+}
@@ -0,0 +1,78 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestDayActionBuckets() {
+	tests := []struct {
+		name      string
+		input     string
+		wantSteps []int
+		wantErr   bool
+	}{
+		{
+			name:      "ровно два часа",
+			input:     "10000,2h",
+			wantSteps: []int{5000, 5000},
+		},
+		{
+			name:      "неполный последний час",
+			input:     "10000,2h30m",
+			wantSteps: []int{4000, 4000, 2000},
+		},
+		{
+			name:      "меньше часа",
+			input:     "3000,20m",
+			wantSteps: []int{3000},
+		},
+		{
+			name:      "округление шагов",
+			input:     "1000,3h",
+			wantSteps: []int{333, 334, 333},
+		},
+		{
+			name:    "некорректные данные",
+			input:   "10000",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			buckets, err := DayActionBuckets(tt.input, 75.0, 1.75)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Nil(suite.T(), buckets)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+
+			var gotSteps []int
+			for i, bucket := range buckets {
+				assert.Equal(suite.T(), i, bucket.HourIndex)
+				gotSteps = append(gotSteps, bucket.Steps)
+			}
+
+			assert.Equal(suite.T(), tt.wantSteps, gotSteps)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestDayActionBucketsTotals() {
+	buckets, err := DayActionBuckets("10000,2h30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	var dist, calories float64
+	for _, bucket := range buckets {
+		dist += bucket.DistanceKm
+		calories += bucket.Calories
+	}
+
+	assert.InDelta(suite.T(), 6.5, dist, 1e-9)
+	assert.InDelta(suite.T(), 295.3125, calories, 1e-9)
+	assert.InDelta(suite.T(), 2.6, buckets[0].DistanceKm, 1e-9)
+	assert.InDelta(suite.T(), 118.125, buckets[0].Calories, 1e-9)
+}
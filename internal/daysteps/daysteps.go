@@ -7,73 +7,215 @@ package daysteps
 import (
 	"fmt"
 	"log"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
 )
 
+// Ошибки разбора данных о шагах. Это те же значения, что и в пакете spentcalories,
+// поэтому errors.Is срабатывает для ошибок обоих пакетов.
+var (
+	ErrInvalidFormat       = spentcalories.ErrInvalidFormat       // неверный формат строки.
+	ErrInvalidSteps        = spentcalories.ErrInvalidSteps        // количество шагов не является числом.
+	ErrNonPositiveSteps    = spentcalories.ErrNonPositiveSteps    // количество шагов не положительно.
+	ErrInvalidDuration     = spentcalories.ErrInvalidDuration     // продолжительность не распознана.
+	ErrNonPositiveDuration = spentcalories.ErrNonPositiveDuration // продолжительность не положительна.
+	ErrImplausibleStepRate = spentcalories.ErrImplausibleStepRate // слишком много шагов в минуту.
+	ErrTooManySteps        = spentcalories.ErrTooManySteps        // количество шагов больше spentcalories.MaxSteps.
+	ErrDurationTooLong     = spentcalories.ErrDurationTooLong     // продолжительность больше spentcalories.MaxDuration.
+)
+
+// Logger используется для записи ошибок в функциях, которые возвращают пустую строку вместо ошибки.
+// По умолчанию это стандартный логгер; чтобы отключить запись, присвойте nil
+// или логгер с выводом в io.Discard.
+var Logger = log.Default()
+
+// logError записывает ошибку err в Logger, если он задан.
+func logError(err error) {
+	if Logger != nil {
+		Logger.Println(err)
+	}
+}
+
+// StepLengthM задаёт среднюю длину шага в метрах, по которой рассчитывается дистанция
+// в сообщениях о дневной активности. По умолчанию равна spentcalories.LenStep.
+var StepLengthM = spentcalories.LenStep
+
 // parsePackage разбирает строку с данными о шагах и продолжительности ходьбы.
-// Принимает строку в формате "количество_шагов,продолжительность" (например, "5000,30m").
+// Принимает строку в формате "количество_шагов,продолжительность" (например, "5000,30m"),
+// поля которой разделены spentcalories.Delimiter.
+// Продолжительность разбирается функцией spentcalories.ParseDuration.
 // Возвращает количество шагов, продолжительность ходьбы и ошибку в случае невалидных данных.
 // Ошибка возвращается, если:
 // - неверный формат строки
 // - количество шагов не является положительным числом
 // - продолжительность не может быть распарсена или не является положительной
+// - темп шагов превышает spentcalories.MaxStepRate
+// - количество шагов или продолжительность превышают ограничения spentcalories.CheckLimits
 func parsePackage(data string) (int, time.Duration, error) {
-	parts := strings.Split(data, ",")
+	return parsePackageSep(data, spentcalories.Delimiter)
+}
+
+// parsePackageSep разбирает строку с данными о шагах, поля которой разделены sep.
+// Если разделитель отличен от запятой, запятая в продолжительности считается десятичным
+// разделителем, например "5000;1,5h".
+// Возвращает количество шагов, продолжительность ходьбы и ошибку в случае невалидных данных.
+func parsePackageSep(data string, sep rune) (int, time.Duration, error) {
+	parts := strings.Split(data, string(sep))
 	if len(parts) != 2 {
-		return 0, 0, fmt.Errorf("invalid data format, expected 'steps,duration', got: %s", data)
+		return 0, 0, fmt.Errorf("%w, expected 'steps%cduration', got: %s", ErrInvalidFormat, sep, data)
 	}
 
 	stepCount, durationText := parts[0], parts[1]
-	count, err := strconv.Atoi(stepCount)
+	if sep != ',' {
+		durationText = strings.ReplaceAll(durationText, ",", ".")
+	}
+	count, err := spentcalories.ParseCount(stepCount)
 	if err != nil {
-		return 0, 0, fmt.Errorf("parsing steps failed: %w", err)
+		return 0, 0, fmt.Errorf("%w: %w", ErrInvalidSteps, err)
 	}
 
 	if count <= 0 {
-		return 0, 0, fmt.Errorf("steps must be greater than zero, got: %d", count)
+		return 0, 0, fmt.Errorf("%w, got: %d", ErrNonPositiveSteps, count)
 	}
 
-	duration, err := time.ParseDuration(durationText)
+	duration, err := spentcalories.ParseDuration(durationText)
 	if err != nil {
-		return 0, 0, fmt.Errorf("parsing duration failed: %w", err)
+		return 0, 0, fmt.Errorf("%w: %w", ErrInvalidDuration, err)
 	}
 
 	if duration <= 0 {
-		return 0, 0, fmt.Errorf("walk duration must be greater than zero, got: %s", duration)
+		return 0, 0, fmt.Errorf("walk %w, got: %s", ErrNonPositiveDuration, duration)
+	}
+
+	if err := spentcalories.CheckStepRate(count, duration); err != nil {
+		return 0, 0, err
+	}
+
+	if err := spentcalories.CheckLimits(count, duration); err != nil {
+		return 0, 0, err
 	}
 
 	return count, duration, nil
 }
 
+// ValidatePackage проверяет строку с данными о шагах без расчёта дистанции и калорий.
+// Возвращает nil, если строка корректна, иначе ту же ошибку разбора, что и DayActionInfo.
+func ValidatePackage(data string) error {
+	_, _, err := parsePackage(data)
+	return err
+}
+
 // DayActionInfo формирует информационное сообщение о дневной активности на основе пройденных шагов.
 // Принимает:
 //   - data: строка в формате "количество_шагов,продолжительность" (например, "5000,30m")
 //   - weight: вес пользователя в килограммах (должен быть > 0)
-//   - height: рост пользователя в сантиметрах (должен быть > 0)
+//   - height: рост пользователя в метрах (должен быть > 0)
 //
 // Возвращает отформатированную строку с информацией о количестве шагов, пройденной дистанции
-// и потраченных калориях. В случае ошибки возвращает пустую строку.
+// и потраченных калориях. В случае ошибки записывает её в лог и возвращает пустую строку.
+// Чтобы получить саму ошибку, используйте DayActionInfoErr.
 func DayActionInfo(data string, weight, height float64) string {
-	if weight <= 0.0 || height <= 0.0 {
+	info, err := DayActionInfoErr(data, weight, height)
+	if err != nil {
+		logError(err)
 		return ""
 	}
 
-	steps, duration, err := parsePackage(data)
+	return info
+}
+
+// DayActionInfoErr работает как DayActionInfo, но вместо пустой строки
+// возвращает ошибку в случае невалидных данных.
+func DayActionInfoErr(data string, weight, height float64) (string, error) {
+	return dayActionInfo(data, weight, height, 0, spentcalories.Russian)
+}
+
+// DayActionInfoWithStep работает как DayActionInfo, но рассчитывает дистанцию
+// по переданной длине шага stepLenM в метрах. Если stepLenM равна нулю,
+// используется средняя длина шага StepLengthM.
+// В случае ошибки, в том числе при отрицательной длине шага, возвращает пустую строку.
+func DayActionInfoWithStep(data string, weight, height, stepLenM float64) string {
+	info, err := dayActionInfo(data, weight, height, stepLenM, spentcalories.Russian)
 	if err != nil {
-		log.Println(err)
+		logError(err)
 		return ""
 	}
 
-	dist := float64(steps) * spentcalories.LenStep / spentcalories.MInKm
-	calories, err := spentcalories.WalkingSpentCalories(steps, weight, height, duration)
+	return info
+}
+
+// DayActionInfoLang работает как DayActionInfo, но формирует сообщение на языке lang.
+// В случае ошибки, в том числе для неизвестного языка, возвращает пустую строку.
+func DayActionInfoLang(data string, weight, height float64, lang spentcalories.Language) string {
+	info, err := dayActionInfo(data, weight, height, 0, lang)
 	if err != nil {
+		logError(err)
 		return ""
 	}
 
-	return fmt.Sprintf("Количество шагов: %d.\nДистанция составила %.2f км.\nВы сожгли %.2f ккал.\n",
-		steps, dist, calories)
+	return info
+}
+
+// dayActionTemplates содержит шаблоны сообщения о дневной активности для каждого языка.
+// Шаблон принимает количество шагов, дистанцию в километрах и количество калорий.
+var dayActionTemplates = map[spentcalories.Language]string{
+	spentcalories.Russian: "Количество шагов: %d.\nДистанция составила %.2f км.\nВы сожгли %.2f ккал.\n",
+	spentcalories.English: "Steps: %d.\nDistance: %.2f km.\nCalories burned: %.2f kcal.\n",
+}
+
+// dayActionInfo формирует информационное сообщение о дневной активности на языке lang
+// с длиной шага stepLenM в метрах, либо StepLengthM, если stepLenM равна нулю.
+// Возвращает отформатированную строку или ошибку в случае невалидных данных.
+func dayActionInfo(data string, weight, height, stepLenM float64, lang spentcalories.Language) (string, error) {
+	tmpl, ok := dayActionTemplates[lang]
+	if !ok {
+		return "", fmt.Errorf("unknown language: %d", lang)
+	}
+
+	steps, dist, calories, err := dayAction(data, weight, height, stepLenM)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(tmpl, steps, dist, calories), nil
+}
+
+// dayAction рассчитывает количество шагов, дистанцию в километрах и количество калорий
+// для дневной активности с длиной шага stepLenM в метрах, либо StepLengthM, если stepLenM равна нулю.
+// Возвращает ошибку в случае невалидных данных.
+func dayAction(data string, weight, height, stepLenM float64) (int, float64, float64, error) {
+	if stepLenM == 0 {
+		stepLenM = StepLengthM
+	}
+
+	if weight <= 0.0 {
+		return 0, 0.0, 0.0, fmt.Errorf("weight must be greater than zero, got: %f", weight)
+	}
+
+	if height <= 0.0 {
+		return 0, 0.0, 0.0, fmt.Errorf("height must be greater than zero, got: %f", height)
+	}
+
+	if warning := spentcalories.HeightWarning(height); warning != nil {
+		logError(warning)
+	}
+
+	if stepLenM < 0.0 {
+		return 0, 0.0, 0.0, fmt.Errorf("step length must not be negative, got: %f", stepLenM)
+	}
+
+	steps, duration, err := parsePackage(data)
+	if err != nil {
+		return 0, 0.0, 0.0, err
+	}
+
+	dist := float64(steps) * stepLenM / spentcalories.MInKm
+	calories, err := spentcalories.WalkingSpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return 0, 0.0, 0.0, err
+	}
+
+	return steps, dist, calories, nil
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
@@ -346,3 +347,316 @@ func (suite *DayStepsTestSuite) TestDayActionInfo() {
 		})
 	}
 }
+
+func (suite *DayStepsTestSuite) TestLogger() {
+	defer func(logger *log.Logger) { Logger = logger }(Logger)
+
+	var buf bytes.Buffer
+	Logger = log.New(&buf, "", 0)
+
+	assert.Empty(suite.T(), DayActionInfo("5000", 75.0, 1.75))
+	assert.Contains(suite.T(), buf.String(), ErrInvalidFormat.Error())
+
+	Logger = nil
+
+	assert.Empty(suite.T(), DayActionInfo("5000", 75.0, 1.75))
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoWithStep() {
+	tests := []struct {
+		name     string
+		input    string
+		stepLenM float64
+		want     string
+	}{
+		{
+			name:     "длина шага по умолчанию",
+			input:    "6000,1h00m",
+			stepLenM: 0,
+			want:     "Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n",
+		},
+		{
+			name:     "короткий шаг",
+			input:    "6000,1h00m",
+			stepLenM: 0.5,
+			want:     "Количество шагов: 6000.\nДистанция составила 3.00 км.\nВы сожгли 177.19 ккал.\n",
+		},
+		{
+			name:     "двойной шаг - двойная дистанция",
+			input:    "6000,1h00m",
+			stepLenM: 1.0,
+			want:     "Количество шагов: 6000.\nДистанция составила 6.00 км.\nВы сожгли 177.19 ккал.\n",
+		},
+		{
+			name:     "отрицательная длина шага",
+			input:    "6000,1h00m",
+			stepLenM: -0.5,
+			want:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got := DayActionInfoWithStep(tt.input, 75.0, 1.75, tt.stepLenM)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoStepLengthM() {
+	defer func(length float64) { StepLengthM = length }(StepLengthM)
+	StepLengthM = 0.8
+
+	got := DayActionInfo("6000,1h00m", 75.0, 1.75)
+	assert.Equal(suite.T(), "Количество шагов: 6000.\nДистанция составила 4.80 км.\nВы сожгли 177.19 ккал.\n", got)
+
+	got = DayActionInfoWithStep("6000,1h00m", 75.0, 1.75, 0.5)
+	assert.Equal(suite.T(), "Количество шагов: 6000.\nДистанция составила 3.00 км.\nВы сожгли 177.19 ккал.\n", got)
+}
+
+func (suite *DayStepsTestSuite) TestParsePackageErrors() {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+		wantMsg string
+	}{
+		{
+			name:    "неверный формат",
+			input:   "678",
+			wantErr: ErrInvalidFormat,
+			wantMsg: "invalid data format, expected 'steps,duration', got: 678",
+		},
+		{
+			name:    "шаги не число",
+			input:   "abc,1h",
+			wantErr: ErrInvalidSteps,
+			wantMsg: `parsing steps failed: strconv.Atoi: parsing "abc": invalid syntax`,
+		},
+		{
+			name:    "дробные шаги",
+			input:   "5000.5,1h",
+			wantErr: ErrInvalidSteps,
+			wantMsg: `parsing steps failed: value "5000.5" is not a whole number`,
+		},
+		{
+			name:    "отрицательные шаги",
+			input:   "-100,1h",
+			wantErr: ErrNonPositiveSteps,
+			wantMsg: "steps must be greater than zero, got: -100",
+		},
+		{
+			name:    "продолжительность не распознана",
+			input:   "678,invalid",
+			wantErr: ErrInvalidDuration,
+			wantMsg: `parsing duration failed: time: invalid duration "invalid"`,
+		},
+		{
+			name:    "нулевая продолжительность",
+			input:   "678,0h",
+			wantErr: ErrNonPositiveDuration,
+			wantMsg: "walk duration must be greater than zero, got: 0s",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, _, err := parsePackage(tt.input)
+
+			assert.ErrorIs(suite.T(), err, tt.wantErr)
+			assert.EqualError(suite.T(), err, tt.wantMsg)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestValidatePackage() {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "корректный ввод", input: "678,0h50m", wantErr: false},
+		{name: "неверный формат", input: "678", wantErr: true},
+		{name: "ноль шагов", input: "0,1h30m", wantErr: true},
+		{name: "неверная продолжительность", input: "678,invalid", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := ValidatePackage(tt.input)
+
+			if !tt.wantErr {
+				assert.NoError(suite.T(), err)
+				return
+			}
+
+			_, _, wantErr := parsePackage(tt.input)
+			assert.EqualError(suite.T(), err, wantErr.Error())
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestParsePackageExtendedDuration() {
+	tests := []struct {
+		name         string
+		input        string
+		wantDuration time.Duration
+	}{
+		{name: "ЧЧ:ММ:СС", input: "5000,1:30:00", wantDuration: 90 * time.Minute},
+		{name: "минуты словом", input: "5000,30 min", wantDuration: 30 * time.Minute},
+		{name: "часы словом", input: "5000,2 hours", wantDuration: 2 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			steps, duration, err := parsePackage(tt.input)
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), 5000, steps)
+			assert.Equal(suite.T(), tt.wantDuration, duration)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestParsePackageSep() {
+	tests := []struct {
+		name         string
+		input        string
+		sep          rune
+		wantSteps    int
+		wantDuration time.Duration
+		wantErr      bool
+	}{
+		{
+			name:         "запятая по умолчанию",
+			input:        "5000,1.5h",
+			sep:          ',',
+			wantSteps:    5000,
+			wantDuration: 90 * time.Minute,
+		},
+		{
+			name:         "точка с запятой и десятичная запятая",
+			input:        "5000;1,5h",
+			sep:          ';',
+			wantSteps:    5000,
+			wantDuration: 90 * time.Minute,
+		},
+		{
+			name:    "строка с другим разделителем",
+			input:   "5000,30m",
+			sep:     ';',
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			steps, duration, err := parsePackageSep(tt.input, tt.sep)
+
+			if tt.wantErr {
+				assert.ErrorIs(suite.T(), err, ErrInvalidFormat)
+				assert.Contains(suite.T(), err.Error(), "expected 'steps;duration'")
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.wantSteps, steps)
+			assert.Equal(suite.T(), tt.wantDuration, duration)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestParsePackageImplausibleStepRate() {
+	steps, duration, err := parsePackage("9000000,30m")
+
+	assert.ErrorIs(suite.T(), err, ErrImplausibleStepRate)
+	assert.Equal(suite.T(), 0, steps)
+	assert.Equal(suite.T(), time.Duration(0), duration)
+}
+
+func (suite *DayStepsTestSuite) TestParsePackageScientificSteps() {
+	for _, input := range []string{"5e3,1h", "5000.0,1h"} {
+		steps, duration, err := parsePackage(input)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), 5000, steps)
+		assert.Equal(suite.T(), time.Hour, duration)
+	}
+}
+
+func (suite *DayStepsTestSuite) TestParsePackageLimits() {
+	_, _, err := parsePackage("500001,24h")
+	assert.ErrorIs(suite.T(), err, ErrTooManySteps)
+
+	_, _, err = parsePackage("6000,25h")
+	assert.ErrorIs(suite.T(), err, ErrDurationTooLong)
+}
+
+func (suite *DayStepsTestSuite) TestParsePackageDelimiter() {
+	defer func(sep rune) { spentcalories.Delimiter = sep }(spentcalories.Delimiter)
+	spentcalories.Delimiter = '|'
+
+	steps, duration, err := parsePackage("5000|1,5h")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 5000, steps)
+	assert.Equal(suite.T(), 90*time.Minute, duration)
+
+	_, _, err = parsePackage("5000,30m")
+	assert.ErrorIs(suite.T(), err, ErrInvalidFormat)
+
+	assert.True(suite.T(), isRestDay("0|0h"))
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoLang() {
+	tests := []struct {
+		name string
+		lang spentcalories.Language
+		want string
+	}{
+		{
+			name: "русский язык",
+			lang: spentcalories.Russian,
+			want: "Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n",
+		},
+		{
+			name: "английский язык",
+			lang: spentcalories.English,
+			want: "Steps: 6000.\nDistance: 3.90 km.\nCalories burned: 177.19 kcal.\n",
+		},
+		{
+			name: "неизвестный язык",
+			lang: spentcalories.Language(99),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got := DayActionInfoLang("6000,1h00m", 75.0, 1.75, tt.lang)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoErr() {
+	got, err := DayActionInfoErr("6000,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), DayActionInfo("6000,1h00m", 75.0, 1.75), got)
+
+	got, err = DayActionInfoErr("abc,1h00m", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrInvalidSteps)
+	assert.Empty(suite.T(), got)
+
+	got, err = DayActionInfoErr("6000,1h00m", 0, 1.75)
+	assert.Error(suite.T(), err)
+	assert.Empty(suite.T(), got)
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoHeightWarning() {
+	defer func(logger *log.Logger) { Logger = logger }(Logger)
+
+	var buf bytes.Buffer
+	Logger = log.New(&buf, "", 0)
+
+	assert.NotEmpty(suite.T(), DayActionInfo("5000,30m", 75.0, 175))
+	assert.Contains(suite.T(), buf.String(), "looks like centimeters")
+}
@@ -0,0 +1,18 @@
+package daysteps
+
+// DedupeConsecutive удаляет из lines строки, совпадающие с предыдущей строкой,
+// например повторы, отправленные устройством при сбое передачи.
+// Совпадающие, но не соседние строки сохраняются, так как это могут быть разные прогулки.
+// Возвращает новый слайс, слайс lines не изменяется.
+func DedupeConsecutive(lines []string) []string {
+	deduped := make([]string, 0, len(lines))
+	for i, line := range lines {
+		if i > 0 && line == lines[i-1] {
+			continue
+		}
+
+		deduped = append(deduped, line)
+	}
+
+	return deduped
+}
@@ -0,0 +1,40 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestDedupeConsecutive() {
+	tests := []struct {
+		name  string
+		lines []string
+		want  []string
+	}{
+		{
+			name:  "соседние повторы",
+			lines: []string{"5000,30m", "5000,30m", "5000,30m", "3000,20m"},
+			want:  []string{"5000,30m", "3000,20m"},
+		},
+		{
+			name:  "несоседние повторы сохраняются",
+			lines: []string{"5000,30m", "3000,20m", "5000,30m"},
+			want:  []string{"5000,30m", "3000,20m", "5000,30m"},
+		},
+		{
+			name:  "без повторов",
+			lines: []string{"5000,30m", "3000,20m"},
+			want:  []string{"5000,30m", "3000,20m"},
+		},
+		{
+			name:  "нет строк",
+			lines: nil,
+			want:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, DedupeConsecutive(tt.lines))
+		})
+	}
+}
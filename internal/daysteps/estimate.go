@@ -0,0 +1,54 @@
+package daysteps
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
+)
+
+// AssumedCadence задаёт предполагаемый темп ходьбы в шагах в минуту,
+// по которому StepsToCalories определяет продолжительность ходьбы.
+var AssumedCadence = 100.0
+
+// StepsToCalories приблизительно рассчитывает количество калорий, потраченных при ходьбе,
+// когда известно только количество шагов. Продолжительность ходьбы определяется
+// по предполагаемому темпу AssumedCadence шагов в минуту.
+// Принимает:
+//   - steps: количество шагов (должно быть > 0)
+//   - weight: вес пользователя в килограммах (должен быть > 0)
+//   - height: рост пользователя в метрах (должен быть > 0)
+//
+// Возвращает количество калорий или ошибку в случае невалидных входных данных.
+func StepsToCalories(steps int, weight, height float64) (float64, error) {
+	if AssumedCadence <= 0.0 {
+		return 0.0, fmt.Errorf("assumed cadence must be greater than zero, got: %f", AssumedCadence)
+	}
+
+	duration := time.Duration(float64(steps) / AssumedCadence * float64(time.Minute))
+
+	return spentcalories.WalkingSpentCalories(steps, weight, height, duration)
+}
+
+// stepEquivalentReference - количество шагов, по которому StepEquivalent определяет расход калорий на шаг.
+const stepEquivalentReference = 10_000
+
+// StepEquivalent переводит количество калорий calories в количество шагов ходьбы, за которое
+// пользователь потратил бы столько же калорий, например чтобы показать бег и велоспорт в единой
+// метрике "шаговых эквивалентов". Расход калорий на шаг определяется так же, как в StepsToCalories:
+// по формуле ходьбы с предполагаемым темпом AssumedCadence шагов в минуту (по умолчанию 100).
+// Результат округляется до целого шага.
+// Возвращает 0, если калории, вес или рост не положительны или расход на шаг рассчитать невозможно.
+func StepEquivalent(calories float64, weight, height float64) int {
+	if calories <= 0.0 {
+		return 0
+	}
+
+	referenceCalories, err := StepsToCalories(stepEquivalentReference, weight, height)
+	if err != nil || referenceCalories <= 0.0 {
+		return 0
+	}
+
+	return int(math.Round(calories / referenceCalories * stepEquivalentReference))
+}
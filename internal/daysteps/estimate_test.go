@@ -0,0 +1,99 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestStepsToCalories() {
+	tests := []struct {
+		name    string
+		steps   int
+		weight  float64
+		want    float64
+		wantErr bool
+	}{
+		{
+			name:   "шесть тысяч шагов",
+			steps:  6000,
+			weight: 75.0,
+			want:   177.1875,
+		},
+		{
+			name:   "другой вес",
+			steps:  6000,
+			weight: 60.0,
+			want:   141.75,
+		},
+		{
+			name:    "нулевое количество шагов",
+			steps:   0,
+			weight:  75.0,
+			wantErr: true,
+		},
+		{
+			name:    "нулевой вес",
+			steps:   6000,
+			weight:  0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := StepsToCalories(tt.steps, tt.weight, 1.75)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Zero(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestStepsToCaloriesAssumedCadence() {
+	defer func(cadence float64) { AssumedCadence = cadence }(AssumedCadence)
+
+	AssumedCadence = 120
+	got, err := StepsToCalories(6000, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 177.1875, got, 1e-9)
+
+	AssumedCadence = 0
+	_, err = StepsToCalories(6000, 75.0, 1.75)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *DayStepsTestSuite) TestStepEquivalent() {
+	tests := []struct {
+		name     string
+		calories float64
+		weight   float64
+		height   float64
+		want     int
+	}{
+		{name: "калории ходьбы", calories: 177.1875, weight: 75.0, height: 1.75, want: 6000},
+		{name: "калории бега", calories: 511.875, weight: 75.0, height: 1.75, want: 17333},
+		{name: "велоспорт", calories: 52.5, weight: 75.0, height: 1.75, want: 1778},
+		{name: "нулевые калории", calories: 0, weight: 75.0, height: 1.75, want: 0},
+		{name: "нулевой вес", calories: 100, weight: 0, height: 1.75, want: 0},
+		{name: "отрицательный рост", calories: 100, weight: 75.0, height: -1, want: 0},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, StepEquivalent(tt.calories, tt.weight, tt.height))
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestStepEquivalentInvalidCadence() {
+	defer func(c float64) { AssumedCadence = c }(AssumedCadence)
+
+	AssumedCadence = 0
+
+	assert.Zero(suite.T(), StepEquivalent(177.1875, 75.0, 1.75))
+}
@@ -0,0 +1,28 @@
+package daysteps
+
+import (
+	"testing"
+
+	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
+)
+
+func FuzzParsePackage(f *testing.F) {
+	for _, seed := range []string{"5000,30m", "5000,1:30:00", "9999999999999999999,1h", "5000,9999999999:00", ",", ""} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, data string) {
+		steps, duration, err := parsePackage(data)
+		if err != nil {
+			return
+		}
+
+		if steps <= 0 || steps > spentcalories.MaxSteps {
+			t.Errorf("parsePackage(%q): steps %d out of bounds", data, steps)
+		}
+
+		if duration <= 0 || duration > spentcalories.MaxDuration {
+			t.Errorf("parsePackage(%q): duration %s out of bounds", data, duration)
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package daysteps
+
+import (
+	"fmt"
+
+	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
+)
+
+// maxGoalPercent - максимальный процент выполнения цели.
+const maxGoalPercent = 100.0
+
+// DayGoalProgress рассчитывает прогресс выполнения дневной цели по калориям.
+// Принимает:
+//   - data: строка в формате "количество_шагов,продолжительность" (например, "5000,30m")
+//   - weight: вес пользователя в килограммах (должен быть > 0)
+//   - height: рост пользователя в метрах (должен быть > 0)
+//   - calorieGoal: цель по калориям (должна быть > 0)
+//
+// Калории рассчитываются по формуле для ходьбы. Возвращает процент выполнения цели,
+// ограниченный 100, и количество оставшихся калорий, которое становится отрицательным
+// при перевыполнении цели. В случае невалидных данных возвращает ошибку.
+func DayGoalProgress(data string, weight, height, calorieGoal float64) (percent float64, remaining float64, err error) {
+	if calorieGoal <= 0.0 {
+		return 0.0, 0.0, fmt.Errorf("calorie goal must be greater than zero, got: %f", calorieGoal)
+	}
+
+	steps, duration, err := parsePackage(data)
+	if err != nil {
+		return 0.0, 0.0, err
+	}
+
+	calories, err := spentcalories.WalkingSpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return 0.0, 0.0, err
+	}
+
+	return min(calories/calorieGoal*maxGoalPercent, maxGoalPercent), calorieGoal - calories, nil
+}
+
+// Коды рекомендаций, которые возвращает Recommendation. Это стабильные значения,
+// по которым клиенты могут выбирать локализованный текст сообщения.
+const (
+	RecommendKeepGoing   = "keep going"   // до цели ещё далеко.
+	RecommendAlmostThere = "almost there" // цель почти достигнута.
+	RecommendGreatJob    = "great job"    // цель достигнута или перевыполнена.
+)
+
+// RecommendationThresholds задаёт пороги рекомендаций в долях цели по калориям.
+type RecommendationThresholds struct {
+	AlmostThere float64 // доля цели, начиная с которой цель считается почти достигнутой.
+	Done        float64 // доля цели, начиная с которой цель считается достигнутой.
+}
+
+// DefaultRecommendationThresholds возвращает пороги, с которыми работает Recommendation:
+// цель почти достигнута с 80% и достигнута со 100%.
+func DefaultRecommendationThresholds() RecommendationThresholds {
+	return RecommendationThresholds{
+		AlmostThere: 0.8,
+		Done:        1.0,
+	}
+}
+
+// Recommendation возвращает код рекомендации по потраченным калориям burned и цели goal
+// с порогами t: RecommendGreatJob, RecommendAlmostThere или RecommendKeepGoing.
+// Если цель не положительна, она считается достигнутой.
+func (t RecommendationThresholds) Recommendation(burned, goal float64) string {
+	if goal <= 0.0 {
+		return RecommendGreatJob
+	}
+
+	switch progress := burned / goal; {
+	case progress >= t.Done:
+		return RecommendGreatJob
+	case progress >= t.AlmostThere:
+		return RecommendAlmostThere
+	default:
+		return RecommendKeepGoing
+	}
+}
+
+// Recommendation возвращает код рекомендации по потраченным калориям burned и цели goal
+// с порогами DefaultRecommendationThresholds.
+func Recommendation(burned, goal float64) string {
+	return DefaultRecommendationThresholds().Recommendation(burned, goal)
+}
@@ -0,0 +1,116 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestDayGoalProgress() {
+	tests := []struct {
+		name          string
+		input         string
+		weight        float64
+		goal          float64
+		wantPercent   float64
+		wantRemaining float64
+		wantErr       bool
+	}{
+		{
+			name:          "половина цели",
+			input:         "6000,1h00m",
+			weight:        75.0,
+			goal:          354.375,
+			wantPercent:   50,
+			wantRemaining: 177.1875,
+		},
+		{
+			name:          "цель достигнута ровно",
+			input:         "6000,1h00m",
+			weight:        75.0,
+			goal:          177.1875,
+			wantPercent:   100,
+			wantRemaining: 0,
+		},
+		{
+			name:          "цель перевыполнена",
+			input:         "6000,1h00m",
+			weight:        75.0,
+			goal:          100,
+			wantPercent:   100,
+			wantRemaining: -77.1875,
+		},
+		{
+			name:    "нулевая цель",
+			input:   "6000,1h00m",
+			weight:  75.0,
+			goal:    0,
+			wantErr: true,
+		},
+		{
+			name:    "отрицательная цель",
+			input:   "6000,1h00m",
+			weight:  75.0,
+			goal:    -100,
+			wantErr: true,
+		},
+		{
+			name:    "некорректные данные",
+			input:   "6000",
+			weight:  75.0,
+			goal:    300,
+			wantErr: true,
+		},
+		{
+			name:    "нулевой вес",
+			input:   "6000,1h00m",
+			weight:  0,
+			goal:    300,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			percent, remaining, err := DayGoalProgress(tt.input, tt.weight, 1.75, tt.goal)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, percent)
+				assert.Equal(suite.T(), 0.0, remaining)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantPercent, percent, 1e-9)
+			assert.InDelta(suite.T(), tt.wantRemaining, remaining, 1e-9)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestRecommendation() {
+	tests := []struct {
+		name   string
+		burned float64
+		goal   float64
+		want   string
+	}{
+		{name: "далеко до цели", burned: 100, goal: 500, want: RecommendKeepGoing},
+		{name: "почти у цели", burned: 400, goal: 500, want: RecommendAlmostThere},
+		{name: "цель достигнута", burned: 500, goal: 500, want: RecommendGreatJob},
+		{name: "цель перевыполнена", burned: 650, goal: 500, want: RecommendGreatJob},
+		{name: "нулевая цель", burned: 0, goal: 0, want: RecommendGreatJob},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, Recommendation(tt.burned, tt.goal))
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestRecommendationThresholds() {
+	thresholds := RecommendationThresholds{AlmostThere: 0.5, Done: 0.9}
+
+	assert.Equal(suite.T(), RecommendKeepGoing, thresholds.Recommendation(200, 500))
+	assert.Equal(suite.T(), RecommendAlmostThere, thresholds.Recommendation(300, 500))
+	assert.Equal(suite.T(), RecommendGreatJob, thresholds.Recommendation(450, 500))
+}
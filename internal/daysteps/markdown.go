@@ -0,0 +1,21 @@
+package daysteps
+
+import (
+	"fmt"
+)
+
+// DayActionInfoMarkdown формирует отчёт о дневной активности в виде таблицы Markdown
+// с количеством шагов, дистанцией и калориями, например для приложений-дневников.
+// Принимает те же параметры, что и DayActionInfoErr, и рассчитывает показатели так же,
+// поэтому значения в таблице совпадают с текстовым отчётом.
+// Возвращает таблицу или ошибку в случае невалидных данных.
+func DayActionInfoMarkdown(data string, weight, height float64) (string, error) {
+	steps, dist, calories, err := dayAction(data, weight, height, 0)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("| Шаги | Дистанция, км | Калории, ккал |\n"+
+		"| ---: | ---: | ---: |\n"+
+		"| %d | %.2f | %.2f |\n", steps, dist, calories), nil
+}
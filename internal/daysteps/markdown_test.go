@@ -0,0 +1,55 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestDayActionInfoMarkdown() {
+	tests := []struct {
+		name    string
+		input   string
+		weight  float64
+		height  float64
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "корректные данные",
+			input:  "6000,1h00m",
+			weight: 75.0,
+			height: 1.75,
+			want: "| Шаги | Дистанция, км | Калории, ккал |\n" +
+				"| ---: | ---: | ---: |\n" +
+				"| 6000 | 3.90 | 177.19 |\n",
+		},
+		{
+			name:    "некорректный формат",
+			input:   "6000",
+			weight:  75.0,
+			height:  1.75,
+			wantErr: true,
+		},
+		{
+			name:    "нулевой вес",
+			input:   "6000,1h00m",
+			weight:  0,
+			height:  1.75,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := DayActionInfoMarkdown(tt.input, tt.weight, tt.height)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
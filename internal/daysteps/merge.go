@@ -0,0 +1,51 @@
+package daysteps
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
+)
+
+// TimedEntry описывает интервал активности с известным временем начала и окончания.
+type TimedEntry struct {
+	Start time.Time // начало интервала.
+	End   time.Time // окончание интервала.
+	Steps int       // количество шагов за интервал.
+}
+
+// Data возвращает интервал в формате "количество_шагов,продолжительность",
+// который принимают DayActionInfo и другие функции пакета. Поля разделяются spentcalories.Delimiter.
+func (e TimedEntry) Data() string {
+	return fmt.Sprintf("%d%c%s", e.Steps, spentcalories.Delimiter, e.End.Sub(e.Start))
+}
+
+// MergeEntries объединяет пересекающиеся интервалы активности, например записанные датчиком дважды.
+// Пересекающиеся интервалы заменяются одним интервалом от самого раннего начала до самого позднего
+// окончания, а количество шагов берётся максимальное среди них, чтобы повторные записи
+// не завышали дневной итог. Интервалы, которые только соприкасаются, не объединяются.
+// Возвращает интервалы, упорядоченные по времени начала. Слайс entries не изменяется.
+func MergeEntries(entries []TimedEntry) []TimedEntry {
+	sorted := slices.Clone(entries)
+	slices.SortStableFunc(sorted, func(a, b TimedEntry) int {
+		return a.Start.Compare(b.Start)
+	})
+
+	var merged []TimedEntry
+	for _, entry := range sorted {
+		last := len(merged) - 1
+		if last < 0 || !entry.Start.Before(merged[last].End) {
+			merged = append(merged, entry)
+			continue
+		}
+
+		if entry.End.After(merged[last].End) {
+			merged[last].End = entry.End
+		}
+
+		merged[last].Steps = max(merged[last].Steps, entry.Steps)
+	}
+
+	return merged
+}
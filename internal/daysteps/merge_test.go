@@ -0,0 +1,101 @@
+package daysteps
+
+import (
+	"time"
+
+	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestMergeEntries() {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, time.March, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name    string
+		entries []TimedEntry
+		want    []TimedEntry
+	}{
+		{
+			name: "повторная запись",
+			entries: []TimedEntry{
+				{Start: at(9, 0), End: at(9, 30), Steps: 3000},
+				{Start: at(9, 0), End: at(9, 30), Steps: 3000},
+			},
+			want: []TimedEntry{{Start: at(9, 0), End: at(9, 30), Steps: 3000}},
+		},
+		{
+			name: "частичное пересечение",
+			entries: []TimedEntry{
+				{Start: at(9, 10), End: at(9, 40), Steps: 2500},
+				{Start: at(9, 0), End: at(9, 30), Steps: 3000},
+				{Start: at(12, 0), End: at(12, 20), Steps: 1500},
+			},
+			want: []TimedEntry{
+				{Start: at(9, 0), End: at(9, 40), Steps: 3000},
+				{Start: at(12, 0), End: at(12, 20), Steps: 1500},
+			},
+		},
+		{
+			name: "вложенный интервал",
+			entries: []TimedEntry{
+				{Start: at(9, 0), End: at(10, 0), Steps: 5000},
+				{Start: at(9, 15), End: at(9, 30), Steps: 6000},
+			},
+			want: []TimedEntry{{Start: at(9, 0), End: at(10, 0), Steps: 6000}},
+		},
+		{
+			name: "соприкасающиеся интервалы",
+			entries: []TimedEntry{
+				{Start: at(9, 30), End: at(10, 0), Steps: 2000},
+				{Start: at(9, 0), End: at(9, 30), Steps: 3000},
+			},
+			want: []TimedEntry{
+				{Start: at(9, 0), End: at(9, 30), Steps: 3000},
+				{Start: at(9, 30), End: at(10, 0), Steps: 2000},
+			},
+		},
+		{
+			name:    "нет интервалов",
+			entries: nil,
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, MergeEntries(tt.entries))
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestTimedEntryData() {
+	start := time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+	entry := TimedEntry{Start: start, End: start.Add(90 * time.Minute), Steps: 9000}
+
+	assert.Equal(suite.T(), "9000,1h30m0s", entry.Data())
+
+	steps, duration, err := parsePackage(entry.Data())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 9000, steps)
+	assert.Equal(suite.T(), 90*time.Minute, duration)
+}
+
+func (suite *DayStepsTestSuite) TestTimedEntryDataDelimiter() {
+	defer func(sep rune) { spentcalories.Delimiter = sep }(spentcalories.Delimiter)
+	spentcalories.Delimiter = '\t'
+
+	start := time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+	entry := TimedEntry{Start: start, End: start.Add(90 * time.Minute), Steps: 9000}
+
+	assert.Equal(suite.T(), "9000\t1h30m0s", entry.Data())
+
+	steps, duration, err := parsePackage(entry.Data())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 9000, steps)
+	assert.Equal(suite.T(), 90*time.Minute, duration)
+
+	_, err = DayActionInfoErr(entry.Data(), 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+}
@@ -0,0 +1,16 @@
+package daysteps
+
+import (
+	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
+)
+
+// Profile дополняет профиль пользователя spentcalories.Profile расчётами дневной активности,
+// поэтому у него доступны и методы тренировок, например TrainingInfo.
+type Profile struct {
+	spentcalories.Profile
+}
+
+// DayActionInfo работает как функция DayActionInfo с весом и ростом пользователя из профиля.
+func (p Profile) DayActionInfo(data string) string {
+	return DayActionInfo(data, p.WeightKg, p.HeightM())
+}
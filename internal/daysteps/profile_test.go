@@ -0,0 +1,20 @@
+package daysteps
+
+import (
+	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestProfileDayActionInfo() {
+	p := Profile{spentcalories.Profile{WeightKg: 75.0, HeightCm: 175}}
+
+	assert.Equal(suite.T(), DayActionInfo("5000,30m", 75.0, 1.75), p.DayActionInfo("5000,30m"))
+	assert.Empty(suite.T(), p.DayActionInfo("5000"))
+
+	want, err := spentcalories.TrainingInfo("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	got, err := p.TrainingInfo("6000,Бег,1h00m")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+}
@@ -0,0 +1,64 @@
+package daysteps
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
+)
+
+// DayActionInfoFromReader формирует информационные сообщения о дневной активности
+// для каждой строки из r. Строки должны быть в формате "количество_шагов,продолжительность".
+// Пустые строки пропускаются. Ошибки в отдельных строках не прерывают обработку:
+// они собираются и возвращаются вместе с номерами строк, а отчёты по корректным строкам
+// возвращаются в исходном порядке.
+func DayActionInfoFromReader(r io.Reader, weight, height float64) ([]string, error) {
+	return readDayActionInfo(r, weight, height, false)
+}
+
+// DayActionInfoCSV формирует информационные сообщения о дневной активности для CSV-данных из r,
+// например выгрузки с заголовком "steps,duration". Если hasHeader равен true, первая непустая
+// строка считается заголовком и пропускается без проверки. Остальные строки обрабатываются
+// так же, как в DayActionInfoFromReader; номера строк в ошибках учитывают заголовок.
+func DayActionInfoCSV(r io.Reader, weight, height float64, hasHeader bool) ([]string, error) {
+	return readDayActionInfo(r, weight, height, hasHeader)
+}
+
+// readDayActionInfo формирует сообщения для строк из r, пропуская первую непустую строку,
+// если skipHeader равен true.
+func readDayActionInfo(r io.Reader, weight, height float64, skipHeader bool) ([]string, error) {
+	var (
+		reports []string
+		errs    []error
+	)
+
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if skipHeader {
+			skipHeader = false
+			continue
+		}
+
+		info, err := dayActionInfo(line, weight, height, 0, spentcalories.Russian)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", lineNum, err))
+			continue
+		}
+
+		reports = append(reports, info)
+	}
+
+	if err := scanner.Err(); err != nil {
+		errs = append(errs, fmt.Errorf("reading data failed: %w", err))
+	}
+
+	return reports, errors.Join(errs...)
+}
@@ -0,0 +1,131 @@
+package daysteps
+
+import (
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestDayActionInfoFromReader() {
+	tests := []struct {
+		name        string
+		input       string
+		want        []string
+		wantErrPart []string
+	}{
+		{
+			name:  "корректные строки",
+			input: "6000,1h00m\n3000,30m\n",
+			want: []string{
+				"Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n",
+				"Количество шагов: 3000.\nДистанция составила 1.95 км.\nВы сожгли 88.59 ккал.\n",
+			},
+		},
+		{
+			name:  "пустые строки и окончания CRLF",
+			input: "\n6000,1h00m\r\n   \n\n3000,30m",
+			want: []string{
+				"Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n",
+				"Количество шагов: 3000.\nДистанция составила 1.95 км.\nВы сожгли 88.59 ккал.\n",
+			},
+		},
+		{
+			name:  "ошибки в отдельных строках",
+			input: "6000,1h00m\nbad\n3000,30m\n0,1h\n",
+			want: []string{
+				"Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n",
+				"Количество шагов: 3000.\nДистанция составила 1.95 км.\nВы сожгли 88.59 ккал.\n",
+			},
+			wantErrPart: []string{"line 2:", "line 4:"},
+		},
+		{
+			name:  "пустой ввод",
+			input: "",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := DayActionInfoFromReader(strings.NewReader(tt.input), 75.0, 1.75)
+
+			assert.Equal(suite.T(), tt.want, got)
+
+			if len(tt.wantErrPart) == 0 {
+				assert.NoError(suite.T(), err)
+				return
+			}
+
+			assert.Error(suite.T(), err)
+			for _, part := range tt.wantErrPart {
+				assert.Contains(suite.T(), err.Error(), part)
+			}
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoCSV() {
+	tests := []struct {
+		name        string
+		input       string
+		hasHeader   bool
+		want        []string
+		wantErrPart []string
+	}{
+		{
+			name:      "заголовок пропускается",
+			input:     "steps,duration\n6000,1h00m\n3000,30m\n",
+			hasHeader: true,
+			want: []string{
+				"Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n",
+				"Количество шагов: 3000.\nДистанция составила 1.95 км.\nВы сожгли 88.59 ккал.\n",
+			},
+		},
+		{
+			name:      "пустые строки перед заголовком",
+			input:     "\n\r\nsteps,duration\r\n6000,1h00m\r\n",
+			hasHeader: true,
+			want: []string{
+				"Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n",
+			},
+		},
+		{
+			name:        "ошибки после заголовка",
+			input:       "steps,duration\n6000,1h00m\nsteps,duration\n",
+			hasHeader:   true,
+			want:        []string{"Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n"},
+			wantErrPart: []string{"line 3:"},
+		},
+		{
+			name:        "без заголовка первая строка проверяется",
+			input:       "steps,duration\n6000,1h00m\n",
+			hasHeader:   false,
+			want:        []string{"Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n"},
+			wantErrPart: []string{"line 1:"},
+		},
+		{
+			name:      "только заголовок",
+			input:     "steps,duration\n",
+			hasHeader: true,
+			want:      nil,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := DayActionInfoCSV(strings.NewReader(tt.input), 75.0, 1.75, tt.hasHeader)
+
+			assert.Equal(suite.T(), tt.want, got)
+
+			if len(tt.wantErrPart) == 0 {
+				assert.NoError(suite.T(), err)
+				return
+			}
+
+			assert.Error(suite.T(), err)
+			for _, part := range tt.wantErrPart {
+				assert.Contains(suite.T(), err.Error(), part)
+			}
+		})
+	}
+}
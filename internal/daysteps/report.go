@@ -0,0 +1,81 @@
+package daysteps
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
+)
+
+// FullDayReport формирует общий отчёт за день по фоновой активности и тренировкам.
+// Принимает:
+//   - steps: строки фоновой активности в формате "количество_шагов,продолжительность"
+//   - workouts: строки тренировок в формате "количество_шагов,тип_активности,продолжительность"
+//   - weight: вес пользователя в килограммах
+//   - height: рост пользователя в метрах
+//
+// Фоновая активность считается как в DayActionInfo, тренировки - как в spentcalories.TrainingData.
+// Шагомер обычно учитывает шаги тренировок в дневной сумме, поэтому, чтобы не считать их дважды,
+// шаги тренировок с шагами (бег, ходьба, подъём по лестнице) вычитаются из фоновой активности,
+// но не ниже нуля. Калории фоновой активности уменьшаются пропорционально оставшимся шагам.
+// Если какие-то строки невалидны, возвращает ошибку с указанием списка и номера строки.
+func FullDayReport(steps []string, workouts []string, weight, height float64) (string, error) {
+	if weight <= 0.0 {
+		return "", fmt.Errorf("weight must be greater than zero, got: %f", weight)
+	}
+
+	if height <= 0.0 {
+		return "", fmt.Errorf("height must be greater than zero, got: %f", height)
+	}
+
+	var (
+		totalSteps, workoutSteps  int
+		stepsCal                  float64
+		workoutsDist, workoutsCal float64
+		errs                      []error
+	)
+
+	for i, entry := range steps {
+		count, duration, err := parsePackage(entry)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("steps entry %d: %w", i, err))
+			continue
+		}
+
+		calories, err := spentcalories.WalkingSpentCalories(count, weight, height, duration)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("steps entry %d: %w", i, err))
+			continue
+		}
+
+		totalSteps += count
+		stepsCal += calories
+	}
+
+	for i, entry := range workouts {
+		result, err := spentcalories.TrainingData(entry, weight, height)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("workout entry %d: %w", i, err))
+			continue
+		}
+
+		workoutSteps += result.Steps
+		workoutsDist += result.DistanceKm
+		workoutsCal += result.Calories
+	}
+
+	if len(errs) > 0 {
+		return "", errors.Join(errs...)
+	}
+
+	backgroundSteps := max(totalSteps-workoutSteps, 0)
+	if totalSteps > 0 {
+		stepsCal *= float64(backgroundSteps) / float64(totalSteps)
+	}
+	stepsDist := float64(backgroundSteps) * StepLengthM / spentcalories.MInKm
+
+	return fmt.Sprintf("Фоновая активность: %d шагов, %.2f км, %.2f ккал.\n"+
+		"Тренировки: %d, %.2f км, %.2f ккал.\nВсего за день: %.2f км, %.2f ккал.\n",
+		backgroundSteps, stepsDist, stepsCal, len(workouts), workoutsDist, workoutsCal,
+		stepsDist+workoutsDist, stepsCal+workoutsCal), nil
+}
@@ -0,0 +1,75 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestFullDayReport() {
+	tests := []struct {
+		name        string
+		steps       []string
+		workouts    []string
+		want        string
+		wantErrPart []string
+	}{
+		{
+			name:     "шаги и тренировки",
+			steps:    []string{"6000,1h00m", "3000,30m"},
+			workouts: []string{"6000,Бег,1h00m"},
+			want: "Фоновая активность: 3000 шагов, 1.95 км, 88.59 ккал.\n" +
+				"Тренировки: 1, 6.83 км, 511.88 ккал.\nВсего за день: 8.78 км, 600.47 ккал.\n",
+		},
+		{
+			name:     "шаги тренировок вычитаются, велоспорт - нет",
+			steps:    []string{"12000,2h00m"},
+			workouts: []string{"3000,Бег,30m", "2000,Подъём по лестнице,20m,15", "100,Велоспорт,30m"},
+			want: "Фоновая активность: 7000 шагов, 4.55 км, 206.72 ккал.\n" +
+				"Тренировки: 3, 5.21 км, 357.38 ккал.\nВсего за день: 9.76 км, 564.10 ккал.\n",
+		},
+		{
+			name:     "тренировка больше фоновой активности",
+			steps:    []string{"2000,20m"},
+			workouts: []string{"3000,Бег,30m"},
+			want: "Фоновая активность: 0 шагов, 0.00 км, 0.00 ккал.\n" +
+				"Тренировки: 1, 3.41 км, 255.94 ккал.\nВсего за день: 3.41 км, 255.94 ккал.\n",
+		},
+		{
+			name:  "только шаги",
+			steps: []string{"6000,1h00m"},
+			want: "Фоновая активность: 6000 шагов, 3.90 км, 177.19 ккал.\n" +
+				"Тренировки: 0, 0.00 км, 0.00 ккал.\nВсего за день: 3.90 км, 177.19 ккал.\n",
+		},
+		{
+			name:        "ошибки в обоих списках",
+			steps:       []string{"6000,1h00m", "bad"},
+			workouts:    []string{"6000,Йога,1h00m", "6000,Бег,1h00m"},
+			wantErrPart: []string{"steps entry 1:", "workout entry 0:"},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := FullDayReport(tt.steps, tt.workouts, 75.0, 1.75)
+
+			if len(tt.wantErrPart) > 0 {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				for _, part := range tt.wantErrPart {
+					assert.Contains(suite.T(), err.Error(), part)
+				}
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestFullDayReportInvalidProfile() {
+	_, err := FullDayReport([]string{"6000,1h00m"}, nil, 0, 1.75)
+	assert.Error(suite.T(), err)
+
+	_, err = FullDayReport([]string{"6000,1h00m"}, nil, 75.0, 0)
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,45 @@
+package daysteps
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
+)
+
+// DayActionInfoAllowZero работает как DayActionInfo, но для дня отдыха с нулевым количеством шагов,
+// например "0,0h", возвращает сообщение с нулевыми дистанцией и калориями вместо ошибки.
+// Продолжительность дня отдыха может быть нулевой, но должна быть разобрана без ошибок.
+// В остальных случаях ошибки возвращает пустую строку.
+func DayActionInfoAllowZero(data string, weight, height float64) string {
+	info, err := DayActionInfoErr(data, weight, height)
+	if errors.Is(err, ErrNonPositiveSteps) && isRestDay(data) {
+		return fmt.Sprintf(dayActionTemplates[spentcalories.Russian], 0, 0.0, 0.0)
+	}
+
+	if err != nil {
+		logError(err)
+		return ""
+	}
+
+	return info
+}
+
+// isRestDay проверяет, что строка в формате "количество_шагов,продолжительность" описывает день отдыха:
+// количество шагов равно нулю, а продолжительность разбирается и не отрицательна.
+func isRestDay(data string) bool {
+	stepCount, durationText, ok := strings.Cut(data, string(spentcalories.Delimiter))
+	if !ok {
+		return false
+	}
+
+	count, err := spentcalories.ParseCount(stepCount)
+	if err != nil || count != 0 {
+		return false
+	}
+
+	duration, err := spentcalories.ParseDuration(durationText)
+
+	return err == nil && duration >= 0
+}
@@ -0,0 +1,67 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestDayActionInfoAllowZero() {
+	tests := []struct {
+		name   string
+		input  string
+		weight float64
+		want   string
+	}{
+		{
+			name:   "день отдыха",
+			input:  "0,0h",
+			weight: 75.0,
+			want:   "Количество шагов: 0.\nДистанция составила 0.00 км.\nВы сожгли 0.00 ккал.\n",
+		},
+		{
+			name:   "ноль шагов с продолжительностью",
+			input:  "0,8h",
+			weight: 75.0,
+			want:   "Количество шагов: 0.\nДистанция составила 0.00 км.\nВы сожгли 0.00 ккал.\n",
+		},
+		{
+			name:   "обычный день совпадает с DayActionInfo",
+			input:  "6000,1h00m",
+			weight: 75.0,
+			want:   "Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n",
+		},
+		{
+			name:   "отрицательное количество шагов",
+			input:  "-100,1h00m",
+			weight: 75.0,
+			want:   "",
+		},
+		{
+			name:   "ноль шагов с некорректной продолжительностью",
+			input:  "0,abc",
+			weight: 75.0,
+			want:   "",
+		},
+		{
+			name:   "ноль шагов с отрицательной продолжительностью",
+			input:  "0,-1h",
+			weight: 75.0,
+			want:   "",
+		},
+		{
+			name:   "день отдыха с нулевым весом",
+			input:  "0,0h",
+			weight: 0,
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, DayActionInfoAllowZero(tt.input, tt.weight, 1.75))
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoZeroStepsUnchanged() {
+	assert.Empty(suite.T(), DayActionInfo("0,0h", 75.0, 1.75))
+}
@@ -0,0 +1,63 @@
+package daysteps
+
+import (
+	"math"
+)
+
+// maxFitnessScore - максимальная оценка дневной активности.
+const maxFitnessScore = 100
+
+// FitnessScoreWeights задаёт веса составляющих оценки дневной активности и цели по калориям
+// и дистанции, относительно которых рассчитываются эти составляющие.
+type FitnessScoreWeights struct {
+	Steps    float64 // вес выполнения цели по шагам.
+	Calories float64 // вес выполнения цели по калориям.
+	Distance float64 // вес выполнения цели по дистанции.
+
+	CalorieGoal    float64 // цель по калориям.
+	DistanceGoalKm float64 // цель по дистанции в километрах.
+}
+
+// DefaultFitnessScoreWeights возвращает веса, с которыми работает FitnessScore:
+// шаги - 50%, калории - 30%, дистанция - 20%, цели 300 ккал и 5 км.
+func DefaultFitnessScoreWeights() FitnessScoreWeights {
+	return FitnessScoreWeights{
+		Steps:          0.5,
+		Calories:       0.3,
+		Distance:       0.2,
+		CalorieGoal:    300,
+		DistanceGoalKm: 5,
+	}
+}
+
+// goalShare возвращает долю выполнения цели goal значением value от 0 до 1.
+// Неположительная цель считается выполненной.
+func goalShare(value, goal float64) float64 {
+	if goal <= 0 {
+		return 1
+	}
+
+	return min(max(value/goal, 0), 1)
+}
+
+// FitnessScore рассчитывает оценку дневной активности от 0 до 100 с весами w.
+// Оценка - взвешенное среднее долей выполнения целей по шагам goalSteps, калориям
+// и дистанции, каждая из которых ограничена единицей, умноженное на 100 и округлённое.
+// Если сумма весов не положительна, возвращает 0.
+func (w FitnessScoreWeights) FitnessScore(steps int, calories, distanceKm float64, goalSteps int) int {
+	total := w.Steps + w.Calories + w.Distance
+	if total <= 0 {
+		return 0
+	}
+
+	score := (w.Steps*goalShare(float64(steps), float64(goalSteps)) +
+		w.Calories*goalShare(calories, w.CalorieGoal) +
+		w.Distance*goalShare(distanceKm, w.DistanceGoalKm)) / total
+
+	return min(max(int(math.Round(score*maxFitnessScore)), 0), maxFitnessScore)
+}
+
+// FitnessScore рассчитывает оценку дневной активности от 0 до 100 с весами DefaultFitnessScoreWeights.
+func FitnessScore(steps int, calories, distanceKm float64, goalSteps int) int {
+	return DefaultFitnessScoreWeights().FitnessScore(steps, calories, distanceKm, goalSteps)
+}
@@ -0,0 +1,37 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestFitnessScore() {
+	tests := []struct {
+		name       string
+		steps      int
+		calories   float64
+		distanceKm float64
+		goalSteps  int
+		want       int
+	}{
+		{name: "все цели выполнены", steps: 10000, calories: 300, distanceKm: 5, goalSteps: 10000, want: 100},
+		{name: "цели перевыполнены", steps: 20000, calories: 900, distanceKm: 15, goalSteps: 10000, want: 100},
+		{name: "половина всех целей", steps: 5000, calories: 150, distanceKm: 2.5, goalSteps: 10000, want: 50},
+		{name: "только шаги", steps: 10000, calories: 0, distanceKm: 0, goalSteps: 10000, want: 50},
+		{name: "нет активности", steps: 0, calories: 0, distanceKm: 0, goalSteps: 10000, want: 0},
+		{name: "отрицательные значения", steps: -100, calories: -5, distanceKm: -1, goalSteps: 10000, want: 0},
+		{name: "нулевая цель по шагам", steps: 0, calories: 0, distanceKm: 0, goalSteps: 0, want: 50},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, FitnessScore(tt.steps, tt.calories, tt.distanceKm, tt.goalSteps))
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestFitnessScoreWeights() {
+	w := FitnessScoreWeights{Steps: 1, Calories: 1, Distance: 0, CalorieGoal: 500, DistanceGoalKm: 10}
+
+	assert.Equal(suite.T(), 75, w.FitnessScore(10000, 250, 0, 10000))
+	assert.Equal(suite.T(), 0, FitnessScoreWeights{}.FitnessScore(10000, 250, 5, 10000))
+}
@@ -0,0 +1,29 @@
+package daysteps
+
+import (
+	"math"
+)
+
+// SmoothSteps сглаживает поминутные показания шагомера простым скользящим средним.
+// Принимает количество шагов по минутам в хронологическом порядке и размер окна windowMin в минутах.
+// Каждое значение заменяется средним по окну из windowMin последних минут, включая текущую,
+// с округлением до целого шага; в первых минутах окно содержит только доступные значения.
+// Окно больше длины raw уменьшается до длины raw, а при windowMin не больше единицы
+// возвращается копия raw. Слайс raw не изменяется.
+func SmoothSteps(raw []int, windowMin int) []int {
+	window := min(max(windowMin, 1), len(raw))
+	smoothed := make([]int, len(raw))
+
+	sum := 0
+	for i, steps := range raw {
+		sum += steps
+		if i >= window {
+			sum -= raw[i-window]
+		}
+
+		size := min(i+1, window)
+		smoothed[i] = int(math.Round(float64(sum) / float64(size)))
+	}
+
+	return smoothed
+}
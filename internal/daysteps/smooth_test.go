@@ -0,0 +1,59 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestSmoothSteps() {
+	tests := []struct {
+		name      string
+		raw       []int
+		windowMin int
+		want      []int
+	}{
+		{
+			name:      "окно три минуты",
+			raw:       []int{100, 0, 110, 90, 200, 100},
+			windowMin: 3,
+			want:      []int{100, 50, 70, 67, 133, 130},
+		},
+		{
+			name:      "окно больше длины данных",
+			raw:       []int{100, 50, 30},
+			windowMin: 10,
+			want:      []int{100, 75, 60},
+		},
+		{
+			name:      "окно одна минута",
+			raw:       []int{100, 0, 110},
+			windowMin: 1,
+			want:      []int{100, 0, 110},
+		},
+		{
+			name:      "неположительное окно",
+			raw:       []int{100, 0, 110},
+			windowMin: 0,
+			want:      []int{100, 0, 110},
+		},
+		{
+			name:      "нет данных",
+			raw:       nil,
+			windowMin: 3,
+			want:      []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, SmoothSteps(tt.raw, tt.windowMin))
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestSmoothStepsDoesNotModifyRaw() {
+	raw := []int{100, 0, 110}
+
+	SmoothSteps(raw, 2)
+
+	assert.Equal(suite.T(), []int{100, 0, 110}, raw)
+}
@@ -0,0 +1,51 @@
+package daysteps
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Kuguchev/fitness-tracker/internal/spentcalories"
+)
+
+// DayAverageSpeed рассчитывает среднюю скорость ходьбы за день в км/ч.
+// Принимает:
+//   - entries: строки в формате "количество_шагов,продолжительность" (например, "5000,30m")
+//   - height: рост пользователя в метрах (должен быть > 0)
+//
+// Дистанция и продолжительность суммируются по всем записям, дистанция рассчитывается
+// функцией spentcalories.Distance. Если какие-то записи невалидны, возвращает ошибку
+// с номерами всех таких записей. Если общая продолжительность равна нулю, например
+// при пустом списке записей, также возвращает ошибку.
+func DayAverageSpeed(entries []string, height float64) (float64, error) {
+	if height <= 0.0 {
+		return 0.0, fmt.Errorf("height must be greater than zero, got: %f", height)
+	}
+
+	var (
+		totalDist     float64
+		totalDuration time.Duration
+		errs          []error
+	)
+
+	for i, entry := range entries {
+		steps, duration, err := parsePackage(entry)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("entry %d: %w", i, err))
+			continue
+		}
+
+		totalDist += spentcalories.Distance(steps, height)
+		totalDuration += duration
+	}
+
+	if len(errs) > 0 {
+		return 0.0, errors.Join(errs...)
+	}
+
+	if totalDuration <= 0 {
+		return 0.0, fmt.Errorf("total duration must be greater than zero, got: %s", totalDuration)
+	}
+
+	return totalDist / totalDuration.Hours(), nil
+}
@@ -0,0 +1,67 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestDayAverageSpeed() {
+	tests := []struct {
+		name    string
+		entries []string
+		height  float64
+		want    float64
+		wantErr bool
+	}{
+		{
+			name:    "одна запись",
+			entries: []string{"6000,1h00m"},
+			height:  1.75,
+			want:    4.725,
+		},
+		{
+			name:    "несколько записей",
+			entries: []string{"6000,1h00m", "2000,20m"},
+			height:  1.75,
+			want:    4.725,
+		},
+		{
+			name:    "разный темп",
+			entries: []string{"4000,30m", "2000,30m"},
+			height:  1.75,
+			want:    4.725,
+		},
+		{
+			name:    "пустой список",
+			entries: nil,
+			height:  1.75,
+			wantErr: true,
+		},
+		{
+			name:    "некорректная запись",
+			entries: []string{"6000,1h00m", "abc,30m"},
+			height:  1.75,
+			wantErr: true,
+		},
+		{
+			name:    "нулевой рост",
+			entries: []string{"6000,1h00m"},
+			height:  0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := DayAverageSpeed(tt.entries, tt.height)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Zero(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
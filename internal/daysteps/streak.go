@@ -0,0 +1,14 @@
+package daysteps
+
+// StepStreak рассчитывает текущую серию дней подряд, в которые была достигнута цель по шагам.
+// Принимает количество шагов по дням в хронологическом порядке и цель по шагам.
+// День засчитывается, если количество шагов не меньше goal.
+// Возвращает длину серии, заканчивающейся последним днём, или 0, если в последний день цель не достигнута.
+func StepStreak(dailySteps []int, goal int) int {
+	streak := 0
+	for i := len(dailySteps) - 1; i >= 0 && dailySteps[i] >= goal; i-- {
+		streak++
+	}
+
+	return streak
+}
@@ -0,0 +1,51 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestStepStreak() {
+	tests := []struct {
+		name       string
+		dailySteps []int
+		goal       int
+		want       int
+	}{
+		{
+			name:       "все дни выполнены",
+			dailySteps: []int{10000, 12000, 10500},
+			goal:       10000,
+			want:       3,
+		},
+		{
+			name:       "серия прервана в середине",
+			dailySteps: []int{11000, 12000, 3000, 10000, 15000},
+			goal:       10000,
+			want:       2,
+		},
+		{
+			name:       "цель не достигнута в последний день",
+			dailySteps: []int{11000, 12000, 9999},
+			goal:       10000,
+			want:       0,
+		},
+		{
+			name:       "ровно цель засчитывается",
+			dailySteps: []int{5000, 10000},
+			goal:       10000,
+			want:       1,
+		},
+		{
+			name:       "пустая история",
+			dailySteps: nil,
+			goal:       10000,
+			want:       0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, StepStreak(tt.dailySteps, tt.goal))
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package daysteps
+
+import (
+	"slices"
+)
+
+// AverageDailySteps рассчитывает среднее количество шагов в день за период.
+// Принимает количество шагов по дням; дни без шагов учитываются как нулевые.
+// Для пустого daily возвращает 0.
+func AverageDailySteps(daily []int) float64 {
+	if len(daily) == 0 {
+		return 0
+	}
+
+	total := 0
+	for _, steps := range daily {
+		total += steps
+	}
+
+	return float64(total) / float64(len(daily))
+}
+
+// MedianDailySteps рассчитывает медиану количества шагов в день за период.
+// Для чётного количества дней возвращает среднее двух центральных значений.
+// Слайс daily не изменяется. Для пустого daily возвращает 0.
+func MedianDailySteps(daily []int) float64 {
+	if len(daily) == 0 {
+		return 0
+	}
+
+	sorted := slices.Clone(daily)
+	slices.Sort(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return float64(sorted[mid-1]+sorted[mid]) / 2
+	}
+
+	return float64(sorted[mid])
+}
@@ -0,0 +1,53 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestAverageDailySteps() {
+	tests := []struct {
+		name  string
+		daily []int
+		want  float64
+	}{
+		{name: "несколько дней", daily: []int{6000, 8000, 10000}, want: 8000},
+		{name: "дни без шагов учитываются", daily: []int{9000, 0, 0}, want: 3000},
+		{name: "дробное среднее", daily: []int{1, 2}, want: 1.5},
+		{name: "один день", daily: []int{7000}, want: 7000},
+		{name: "пустой период", daily: nil, want: 0},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.InDelta(suite.T(), tt.want, AverageDailySteps(tt.daily), 1e-9)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestMedianDailySteps() {
+	tests := []struct {
+		name  string
+		daily []int
+		want  float64
+	}{
+		{name: "нечётное количество дней", daily: []int{10000, 2000, 6000}, want: 6000},
+		{name: "чётное количество дней", daily: []int{8000, 2000, 6000, 0}, want: 4000},
+		{name: "выброс не влияет", daily: []int{5000, 5500, 40000}, want: 5500},
+		{name: "один день", daily: []int{7000}, want: 7000},
+		{name: "пустой период", daily: []int{}, want: 0},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.InDelta(suite.T(), tt.want, MedianDailySteps(tt.daily), 1e-9)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestMedianDailyStepsDoesNotModifyInput() {
+	daily := []int{3, 1, 2}
+
+	MedianDailySteps(daily)
+
+	assert.Equal(suite.T(), []int{3, 1, 2}, daily)
+}
@@ -0,0 +1,68 @@
+package spentcalories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// TrainingInfoBatch формирует информационные сообщения для набора тренировок параллельно.
+// Принимает строки с данными о тренировках и те же параметры пользователя, что и TrainingInfo.
+// Тренировки обрабатываются пулом из runtime.GOMAXPROCS(0) горутин.
+// Возвращает сообщения и ошибки в порядке входных данных: для i-й тренировки
+// сообщение находится в reports[i], а ошибка - в errs[i].
+func TrainingInfoBatch(data []string, weight, height float64) (reports []string, errs []error) {
+	reports = make([]string, len(data))
+	errs = make([]error, len(data))
+
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for range min(runtime.GOMAXPROCS(0), len(data)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				reports[i], errs[i] = TrainingInfo(data[i], weight, height)
+			}
+		}()
+	}
+
+	for i := range data {
+		jobs <- i
+	}
+	close(jobs)
+
+	wg.Wait()
+
+	return reports, errs
+}
+
+// TrainingInfoBatchCtx формирует информационные сообщения для набора тренировок последовательно,
+// проверяя отмену ctx перед каждой тренировкой.
+// Для невалидной тренировки сообщение остаётся пустым, а ошибка с её индексом
+// добавляется к общей ошибке. Если ctx отменён, обработка прерывается и возвращаются
+// сообщения по уже обработанным тренировкам вместе с ctx.Err().
+func TrainingInfoBatchCtx(ctx context.Context, data []string, weight, height float64) ([]string, error) {
+	reports := make([]string, 0, len(data))
+
+	var errs []error
+	for i, entry := range data {
+		select {
+		case <-ctx.Done():
+			return reports, ctx.Err()
+		default:
+		}
+
+		info, err := TrainingInfo(entry, weight, height)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("entry %d: %w", i, err))
+		}
+
+		reports = append(reports, info)
+	}
+
+	return reports, errors.Join(errs...)
+}
@@ -0,0 +1,86 @@
+package spentcalories
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoBatch() {
+	data := []string{"6000,Ходьба,1h00m", "6000,Ходьба", "3000,Бег,30m", "40,Плавание,30m", "6000,Йога,1h00m"}
+
+	reports, errs := TrainingInfoBatch(data, 75.0, 1.75)
+
+	assert.Len(suite.T(), reports, len(data))
+	assert.Len(suite.T(), errs, len(data))
+
+	for i, entry := range data {
+		want, wantErr := TrainingInfo(entry, 75.0, 1.75)
+		assert.Equal(suite.T(), want, reports[i], "entry %d", i)
+		assert.Equal(suite.T(), wantErr, errs[i], "entry %d", i)
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoBatchEmpty() {
+	reports, errs := TrainingInfoBatch(nil, 75.0, 1.75)
+
+	assert.Empty(suite.T(), reports)
+	assert.Empty(suite.T(), errs)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoBatchCtx() {
+	data := []string{"6000,Ходьба,1h00m", "6000,Ходьба", "3000,Бег,30m"}
+
+	reports, err := TrainingInfoBatchCtx(context.Background(), data, 75.0, 1.75)
+
+	assert.ErrorIs(suite.T(), err, ErrInvalidFormat)
+	assert.ErrorContains(suite.T(), err, "entry 1: ")
+	assert.Len(suite.T(), reports, len(data))
+	assert.Empty(suite.T(), reports[1])
+
+	for _, i := range []int{0, 2} {
+		want, wantErr := TrainingInfo(data[i], 75.0, 1.75)
+		assert.NoError(suite.T(), wantErr)
+		assert.Equal(suite.T(), want, reports[i], "entry %d", i)
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoBatchCtxCanceled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	reports, err := TrainingInfoBatchCtx(ctx, []string{"6000,Ходьба,1h00m", "3000,Бег,30m"}, 75.0, 1.75)
+
+	assert.ErrorIs(suite.T(), err, context.Canceled)
+	assert.Empty(suite.T(), reports)
+}
+
+// benchmarkEntries возвращает n корректных строк с данными о тренировках.
+func benchmarkEntries(n int) []string {
+	entries := make([]string, n)
+	for i := range entries {
+		entries[i] = fmt.Sprintf("%d,Бег,30m", 3000+i%1000)
+	}
+
+	return entries
+}
+
+func BenchmarkTrainingInfoSerial(b *testing.B) {
+	entries := benchmarkEntries(100_000)
+
+	for b.Loop() {
+		for _, entry := range entries {
+			_, _ = TrainingInfo(entry, 75.0, 1.75)
+		}
+	}
+}
+
+func BenchmarkTrainingInfoBatch(b *testing.B) {
+	entries := benchmarkEntries(100_000)
+
+	for b.Loop() {
+		TrainingInfoBatch(entries, 75.0, 1.75)
+	}
+}
@@ -0,0 +1,44 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// Названия составляющих расхода калорий, которые возвращает CalorieBreakdown.
+const (
+	ComponentBase      = "base"      // расход при ходьбе по ровной твёрдой поверхности.
+	ComponentElevation = "elevation" // добавка за набор высоты.
+	ComponentSurface   = "surface"   // добавка или уменьшение за тип поверхности.
+)
+
+// CalorieBreakdown раскладывает расход калорий при ходьбе на составляющие, например
+// "base 200 + elevation 50 + surface 30", чтобы пользователь видел, из чего складывается результат.
+// Принимает те же параметры, что и WalkingSpentCalories, набор высоты elevationGainM в метрах
+// и тип поверхности s. Составляющие рассчитываются так же, как в уточнённых формулах:
+//   - ComponentBase - результат WalkingSpentCalories;
+//   - ComponentElevation - добавка WalkingSpentCaloriesElevation, не меньше нуля;
+//   - ComponentSurface - разница между WalkingSpentCaloriesSurface и базовым расходом,
+//     отрицательна для поверхностей с множителем меньше единицы.
+//
+// Сумма значений равна общему расходу калорий.
+// Возвращает составляющие или ошибку в случае невалидных входных данных или неизвестного типа поверхности.
+func CalorieBreakdown(steps int, weight, height float64, d time.Duration, elevationGainM float64,
+	s Surface,
+) (map[string]float64, error) {
+	multiplier, ok := surfaceMultipliers[s]
+	if !ok {
+		return nil, fmt.Errorf("unknown surface: %d", s)
+	}
+
+	base, err := WalkingSpentCalories(steps, weight, height, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]float64{
+		ComponentBase:      base,
+		ComponentElevation: max(elevationCaloriesCoefficient*weight*elevationGainM, 0.0),
+		ComponentSurface:   base * (multiplier - 1),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCalorieBreakdown() {
+	tests := []struct {
+		name           string
+		elevationGainM float64
+		surface        Surface
+		want           map[string]float64
+	}{
+		{
+			name:    "ровная дорога",
+			surface: Road,
+			want:    map[string]float64{ComponentBase: 177.1875, ComponentElevation: 0, ComponentSurface: 0},
+		},
+		{
+			name:           "подъём по тропе",
+			elevationGainM: 100,
+			surface:        Trail,
+			want:           map[string]float64{ComponentBase: 177.1875, ComponentElevation: 70.5, ComponentSurface: 26.578125},
+		},
+		{
+			name:           "спуск по беговой дорожке",
+			elevationGainM: -50,
+			surface:        Treadmill,
+			want:           map[string]float64{ComponentBase: 177.1875, ComponentElevation: 0, ComponentSurface: -8.859375},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CalorieBreakdown(6000, 75.0, 1.75, time.Hour, tt.elevationGainM, tt.surface)
+			assert.NoError(suite.T(), err)
+			assert.Len(suite.T(), got, len(tt.want))
+			for component, want := range tt.want {
+				assert.InDelta(suite.T(), want, got[component], 1e-9, component)
+			}
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestCalorieBreakdownSumsToTotal() {
+	got, err := CalorieBreakdown(6000, 75.0, 1.75, time.Hour, 100, Sand)
+	assert.NoError(suite.T(), err)
+
+	surface, err := WalkingSpentCaloriesSurface(6000, 75.0, 1.75, time.Hour, Sand)
+	assert.NoError(suite.T(), err)
+	elevation, err := WalkingSpentCaloriesElevation(6000, 75.0, 1.75, time.Hour, 100)
+	assert.NoError(suite.T(), err)
+
+	var sum float64
+	for _, v := range got {
+		sum += v
+	}
+
+	assert.InDelta(suite.T(), surface+elevation-got[ComponentBase], sum, 1e-9)
+}
+
+func (suite *SpentCaloriesTestSuite) TestCalorieBreakdownErrors() {
+	_, err := CalorieBreakdown(6000, 75.0, 1.75, time.Hour, 0, Surface(42))
+	assert.Error(suite.T(), err)
+
+	got, err := CalorieBreakdown(0, 75.0, 1.75, time.Hour, 0, Road)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveSteps)
+	assert.Nil(suite.T(), got)
+}
@@ -0,0 +1,42 @@
+package spentcalories
+
+import (
+	"fmt"
+)
+
+// DistanceToBurnCalories рассчитывает дистанцию в километрах, которую нужно преодолеть,
+// чтобы потратить targetKcal калорий, например "пройти X км, чтобы сжечь перекус".
+// Обращает формулу RunningCaloriesByDistance с коэффициентом типа активности:
+// калории = вес * дистанция * коэффициент, поэтому от скорости результат не зависит.
+// Для активности "Подъём по лестнице" учитывается только горизонтальная часть, без пролётов.
+// Если включён UseMETModel, калории зависят от продолжительности, а не от дистанции,
+// поэтому для типов активности из METValues возвращается ошибка.
+// Принимает:
+//   - targetKcal: количество калорий (должно быть > 0)
+//   - weight: вес пользователя в килограммах (должен быть > 0)
+//   - height: рост пользователя в метрах (должен быть > 0), в формулах по дистанции не используется
+//   - activity: тип активности, один из встроенных типов
+//
+// Возвращает дистанцию в километрах или ошибку в случае невалидных входных данных
+// или неизвестного типа активности.
+func DistanceToBurnCalories(targetKcal, weight, height float64, activity string) (float64, error) {
+	if targetKcal <= 0.0 {
+		return 0.0, fmt.Errorf("target calories must be greater than zero, got: %f", targetKcal)
+	}
+
+	if err := checkBody(weight, height); err != nil {
+		return 0.0, err
+	}
+
+	activity = normalizeActivity(activity)
+	coefficient, ok := distanceCaloriesCoefficient(activity)
+	if !ok {
+		return 0.0, unknownActivityError(activity)
+	}
+
+	if _, ok := METValues[activity]; UseMETModel && ok {
+		return 0.0, fmt.Errorf("distance cannot be derived from calories for %s with the MET model enabled", activity)
+	}
+
+	return targetKcal / (weight * coefficient), nil
+}
@@ -0,0 +1,69 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestDistanceToBurnCalories() {
+	tests := []struct {
+		name       string
+		targetKcal float64
+		weight     float64
+		height     float64
+		activity   string
+		want       float64
+		wantErr    bool
+		errIs      error
+	}{
+		{name: "бег", targetKcal: 300, weight: 75, height: 1.75, activity: "Бег", want: 4},
+		{name: "ходьба", targetKcal: 300, weight: 75, height: 1.75, activity: "ходьба", want: 8},
+		{name: "велоспорт", targetKcal: 105, weight: 75, height: 1.75, activity: "Велоспорт", want: 4},
+		{name: "плавание", targetKcal: 300, weight: 75, height: 1.75, activity: "Плавание", want: 1},
+		{name: "нулевые калории", targetKcal: 0, weight: 75, height: 1.75, activity: "Бег", wantErr: true},
+		{name: "нулевой вес", targetKcal: 300, weight: 0, height: 1.75, activity: "Бег", wantErr: true},
+		{name: "отрицательный рост", targetKcal: 300, weight: 75, height: -1, activity: "Бег", wantErr: true},
+		{
+			name: "неизвестный тип тренировки", targetKcal: 300, weight: 75, height: 1.75, activity: "Йога",
+			wantErr: true, errIs: ErrUnknownActivity,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := DistanceToBurnCalories(tt.targetKcal, tt.weight, tt.height, tt.activity)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				if tt.errIs != nil {
+					assert.ErrorIs(suite.T(), err, tt.errIs)
+				}
+				assert.Zero(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestDistanceToBurnCaloriesInvertsRunning() {
+	distanceKm, err := DistanceToBurnCalories(511.875, 75.0, 1.75, "Бег")
+	assert.NoError(suite.T(), err)
+
+	calories, err := RunningCaloriesByDistance(distanceKm, 75.0, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 511.875, calories, 1e-9)
+	assert.InDelta(suite.T(), 6.825, distanceKm, 1e-9)
+}
+
+func (suite *SpentCaloriesTestSuite) TestDistanceToBurnCaloriesMETModel() {
+	defer func(use bool) { UseMETModel = use }(UseMETModel)
+	UseMETModel = true
+
+	got, err := DistanceToBurnCalories(300, 75.0, 1.75, "Бег")
+	assert.Error(suite.T(), err)
+	assert.Zero(suite.T(), got)
+}
@@ -0,0 +1,19 @@
+package spentcalories
+
+import (
+	"time"
+)
+
+// minRunningCadence - каденс бега в шагах в минуту, ниже которого в отчёт добавляется предупреждение.
+const minRunningCadence = 160
+
+// Cadence рассчитывает каденс - количество шагов в минуту.
+// Принимает количество шагов и продолжительность активности.
+// Возвращает 0, если количество шагов или продолжительность не положительны.
+func Cadence(steps int, duration time.Duration) float64 {
+	if steps <= 0 || duration <= 0 {
+		return 0
+	}
+
+	return float64(steps) / duration.Minutes()
+}
@@ -0,0 +1,72 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCadence() {
+	tests := []struct {
+		name     string
+		steps    int
+		duration time.Duration
+		want     float64
+	}{
+		{
+			name:     "сто шагов в минуту",
+			steps:    6000,
+			duration: time.Hour,
+			want:     100,
+		},
+		{
+			name:     "беговой каденс",
+			steps:    5400,
+			duration: 30 * time.Minute,
+			want:     180,
+		},
+		{
+			name:     "нулевая продолжительность",
+			steps:    6000,
+			duration: 0,
+			want:     0,
+		},
+		{
+			name:     "отрицательная продолжительность",
+			steps:    6000,
+			duration: -time.Minute,
+			want:     0,
+		},
+		{
+			name:     "нулевое количество шагов",
+			steps:    0,
+			duration: time.Hour,
+			want:     0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.InDelta(suite.T(), tt.want, Cadence(tt.steps, tt.duration), 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoCadence() {
+	got, err := TrainingInfo("5400,Бег,30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Каденс: 180.00 шаг/мин\n")
+	assert.NotContains(suite.T(), got, "Внимание")
+
+	got, err = TrainingInfo("3000,Бег,30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Внимание: каденс ниже 160 шаг/мин\n")
+
+	got, err = TrainingInfo("3000,Ходьба,30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.NotContains(suite.T(), got, "Внимание")
+
+	got, err = TrainingInfo("40,Плавание,30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.NotContains(suite.T(), got, "Каденс")
+}
@@ -0,0 +1,120 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// Calculator рассчитывает дистанцию и калории с настраиваемыми коэффициентами.
+// Функции пакета используют калькулятор с коэффициентами по умолчанию, см. NewDefaultCalculator.
+type Calculator struct {
+	WalkingCoefficient    float64 // коэффициент для расчета калорий при ходьбе.
+	StepLengthCoefficient float64 // коэффициент для расчета длины шага на основе роста.
+	LenStep               float64 // средняя длина шага в метрах.
+
+	// RunningStepLengthCoefficient - коэффициент для расчета длины шага при беге на основе роста.
+	// Если равен нулю, при беге используется StepLengthCoefficient.
+	RunningStepLengthCoefficient float64
+}
+
+// defaultCalculator используется функциями пакета.
+var defaultCalculator = NewDefaultCalculator()
+
+// NewDefaultCalculator создаёт калькулятор с коэффициентами, которые используют функции пакета.
+func NewDefaultCalculator() Calculator {
+	return Calculator{
+		WalkingCoefficient:    walkingCaloriesCoefficient,
+		StepLengthCoefficient: stepLengthCoefficient,
+		LenStep:               LenStep,
+
+		RunningStepLengthCoefficient: runningStepLengthCoefficient,
+	}
+}
+
+// stepLengthCoefficient возвращает коэффициент длины шага для указанного типа активности:
+// RunningStepLengthCoefficient для бега, если он задан, иначе StepLengthCoefficient.
+func (c Calculator) stepLengthCoefficient(activity string) float64 {
+	if activity == running && c.RunningStepLengthCoefficient > 0 {
+		return c.RunningStepLengthCoefficient
+	}
+
+	return c.StepLengthCoefficient
+}
+
+// Distance рассчитывает дистанцию в километрах по количеству шагов и росту пользователя в метрах.
+// Длина шага равна росту, умноженному на StepLengthCoefficient.
+// Возвращает 0, если количество шагов или рост не положительны.
+func (c Calculator) Distance(steps int, height float64) float64 {
+	return c.ActivityDistance(steps, height, "")
+}
+
+// ActivityDistance работает как Distance, но выбирает коэффициент длины шага по типу активности:
+// для бега используется RunningStepLengthCoefficient, для остальных активностей - StepLengthCoefficient.
+func (c Calculator) ActivityDistance(steps int, height float64, activity string) float64 {
+	if steps <= 0 || height <= 0 {
+		return 0.0
+	}
+
+	return c.stepLengthCoefficient(activity) * height * float64(steps) / float64(MInKm)
+}
+
+// AverageDistance рассчитывает дистанцию в километрах по количеству шагов и средней длине шага LenStep.
+// Возвращает 0, если количество шагов не положительно.
+func (c Calculator) AverageDistance(steps int) float64 {
+	if steps <= 0 {
+		return 0.0
+	}
+
+	return c.LenStep * float64(steps) / float64(MInKm)
+}
+
+// RunningCalories рассчитывает количество потраченных калорий при беге.
+// Дистанция определяется методом ActivityDistance для бега, дальше используется RunningCaloriesByDistance.
+// Принимает:
+//   - steps: количество шагов (должно быть > 0)
+//   - weight: вес пользователя в килограммах (должен быть > 0)
+//   - height: рост пользователя в метрах (должен быть > 0)
+//   - duration: продолжительность активности (должна быть > 0)
+//
+// Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных.
+func (c Calculator) RunningCalories(steps int, weight, height float64, duration time.Duration) (float64, error) {
+	return c.caloriesByDistance(steps, weight, height, duration, running)
+}
+
+// caloriesByDistance проверяет входные данные и рассчитывает калории функцией RunningCaloriesByDistance
+// по дистанции, определённой методом ActivityDistance для типа активности activity.
+func (c Calculator) caloriesByDistance(steps int, weight, height float64, duration time.Duration,
+	activity string,
+) (float64, error) {
+	if steps <= 0 {
+		return 0.0, fmt.Errorf("%w, got: %d", ErrNonPositiveSteps, steps)
+	}
+
+	if weight <= 0.0 {
+		return 0.0, fmt.Errorf("weight must be greater than zero, got: %f", weight)
+	}
+
+	if height <= 0.0 {
+		return 0.0, fmt.Errorf("height must be greater than zero, got: %f", height)
+	}
+
+	if duration <= 0 {
+		return 0.0, fmt.Errorf("%w, got: %s", ErrNonPositiveDuration, duration)
+	}
+
+	return RunningCaloriesByDistance(c.ActivityDistance(steps, height, activity), weight, duration)
+}
+
+// WalkingCalories рассчитывает количество потраченных калорий при ходьбе.
+// Рассчитывает калории так же, как RunningCalories, но по дистанции с длиной шага при ходьбе,
+// и применяет коэффициент WalkingCoefficient.
+// Принимает те же параметры, что и RunningCalories.
+// Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных.
+func (c Calculator) WalkingCalories(steps int, weight, height float64, duration time.Duration) (float64, error) {
+	calories, err := c.caloriesByDistance(steps, weight, height, duration, walking)
+	if err != nil {
+		return 0.0, err
+	}
+
+	return calories * c.WalkingCoefficient, nil
+}
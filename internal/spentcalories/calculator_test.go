@@ -0,0 +1,79 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestDefaultCalculatorMatchesPackage() {
+	calc := NewDefaultCalculator()
+
+	running, err := calc.RunningCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	wantRunning, _ := RunningSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.Equal(suite.T(), wantRunning, running)
+
+	walking, err := calc.WalkingCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	wantWalking, _ := WalkingSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.Equal(suite.T(), wantWalking, walking)
+
+	assert.Equal(suite.T(), distance(6000, 1.75, ""), calc.Distance(6000, 1.75))
+	assert.InDelta(suite.T(), 3.9, calc.AverageDistance(6000), 1e-9)
+}
+
+func (suite *SpentCaloriesTestSuite) TestCalculatorCustomCoefficients() {
+	calc := Calculator{
+		WalkingCoefficient:    0.25,
+		StepLengthCoefficient: 0.9,
+		LenStep:               1.3,
+	}
+
+	assert.InDelta(suite.T(), 9.45, calc.Distance(6000, 1.75), 1e-9)
+	assert.InDelta(suite.T(), 7.8, calc.AverageDistance(6000), 1e-9)
+
+	running, err := calc.RunningCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 708.75, running, 1e-9)
+
+	walking, err := calc.WalkingCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 177.1875, walking, 1e-9)
+}
+
+func (suite *SpentCaloriesTestSuite) TestCalculatorInvalidInput() {
+	calc := NewDefaultCalculator()
+
+	_, err := calc.RunningCalories(0, 75.0, 1.75, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveSteps)
+
+	_, err = calc.WalkingCalories(6000, 75.0, 1.75, 0)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDuration)
+
+	assert.Equal(suite.T(), 0.0, calc.Distance(6000, 0))
+	assert.Equal(suite.T(), 0.0, calc.AverageDistance(-1))
+}
+
+func (suite *SpentCaloriesTestSuite) TestCalculatorActivityDistance() {
+	calc := NewDefaultCalculator()
+
+	assert.InDelta(suite.T(), 6.825, calc.ActivityDistance(6000, 1.75, running), 1e-9)
+	assert.InDelta(suite.T(), 4.725, calc.ActivityDistance(6000, 1.75, walking), 1e-9)
+	assert.InDelta(suite.T(), 4.725, calc.ActivityDistance(6000, 1.75, "Йога"), 1e-9)
+	assert.Equal(suite.T(), calc.Distance(6000, 1.75), calc.ActivityDistance(6000, 1.75, ""))
+
+	calc.RunningStepLengthCoefficient = 0
+	assert.InDelta(suite.T(), 4.725, calc.ActivityDistance(6000, 1.75, running), 1e-9)
+}
+
+func (suite *SpentCaloriesTestSuite) TestActivityDistanceRunningLongerThanWalking() {
+	run, err := TrainingData("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	walk, err := TrainingData("6000,Ходьба,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	assert.InDelta(suite.T(), 6.825, run.DistanceKm, 1e-9)
+	assert.InDelta(suite.T(), 4.725, walk.DistanceKm, 1e-9)
+}
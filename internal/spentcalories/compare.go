@@ -0,0 +1,26 @@
+package spentcalories
+
+import (
+	"fmt"
+)
+
+// CompareTrainings сравнивает две тренировки, например текущую a и предыдущую b.
+// Принимает строки с данными о тренировках в формате TrainingData и параметры пользователя.
+// Возвращает разницу a - b в дистанции, скорости и калориях, каждое значение выводится со знаком.
+// Если какая-то из тренировок невалидна, возвращает ошибку с указанием этой тренировки.
+func CompareTrainings(a, b string, weight, height float64) (string, error) {
+	first, err := TrainingData(a, weight, height)
+	if err != nil {
+		return "", fmt.Errorf("first training: %w", err)
+	}
+
+	second, err := TrainingData(b, weight, height)
+	if err != nil {
+		return "", fmt.Errorf("second training: %w", err)
+	}
+
+	return fmt.Sprintf("Дистанция: %+.2f км.\nСкорость: %+.2f км/ч\nСожгли калорий: %+.2f\n",
+		first.DistanceKm-second.DistanceKm,
+		first.SpeedKmh-second.SpeedKmh,
+		first.Calories-second.Calories), nil
+}
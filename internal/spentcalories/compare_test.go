@@ -0,0 +1,61 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCompareTrainings() {
+	tests := []struct {
+		name    string
+		a       string
+		b       string
+		want    string
+		wantErr string
+	}{
+		{
+			name: "улучшение",
+			a:    "6000,Бег,1h00m",
+			b:    "3000,Бег,1h00m",
+			want: "Дистанция: +3.41 км.\nСкорость: +3.41 км/ч\nСожгли калорий: +255.94\n",
+		},
+		{
+			name: "ухудшение",
+			a:    "3000,Бег,1h00m",
+			b:    "6000,Бег,1h00m",
+			want: "Дистанция: -3.41 км.\nСкорость: -3.41 км/ч\nСожгли калорий: -255.94\n",
+		},
+		{
+			name: "без изменений",
+			a:    "6000,Ходьба,1h00m",
+			b:    "6000,Ходьба,1h00m",
+			want: "Дистанция: +0.00 км.\nСкорость: +0.00 км/ч\nСожгли калорий: +0.00\n",
+		},
+		{
+			name:    "некорректная первая тренировка",
+			a:       "6000,Бег",
+			b:       "6000,Бег,1h00m",
+			wantErr: "first training",
+		},
+		{
+			name:    "некорректная вторая тренировка",
+			a:       "6000,Бег,1h00m",
+			b:       "6000,Йога,1h00m",
+			wantErr: "second training",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CompareTrainings(tt.a, tt.b, 75.0, 1.75)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(suite.T(), err, tt.wantErr)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package spentcalories
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ParseCount разбирает целое количество шагов, оборотов педалей или бассейнов.
+// Кроме обычной записи целого числа принимает целые числа в виде числа с плавающей точкой,
+// например "5e3" или "5000.0", которые получаются при преобразовании JSON в CSV.
+// Возвращает ошибку, если значение не является числом, имеет дробную часть, например "5000.5",
+// или не помещается в int.
+func ParseCount(text string) (int, error) {
+	count, err := strconv.Atoi(text)
+	if err == nil {
+		return count, nil
+	}
+
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, err
+	}
+
+	value, floatErr := strconv.ParseFloat(text, 64)
+	if floatErr != nil {
+		return 0, err
+	}
+
+	if value != math.Trunc(value) {
+		return 0, fmt.Errorf("value %q is not a whole number", text)
+	}
+
+	if value < math.MinInt64 || value >= math.MaxInt64 {
+		return 0, fmt.Errorf("value %q is out of range", text)
+	}
+
+	return int(value), nil
+}
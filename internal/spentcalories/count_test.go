@@ -0,0 +1,58 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestParseCount() {
+	tests := []struct {
+		name    string
+		input   string
+		want    int
+		wantErr bool
+	}{
+		{name: "целое число", input: "5000", want: 5000},
+		{name: "экспоненциальная запись", input: "5e3", want: 5000},
+		{name: "экспоненциальная запись с дробной мантиссой", input: "1.25E4", want: 12500},
+		{name: "целое с нулевой дробной частью", input: "5000.0", want: 5000},
+		{name: "отрицательное число", input: "-5e3", want: -5000},
+		{name: "дробное число", input: "5000.5", wantErr: true},
+		{name: "дробная экспоненциальная запись", input: "5.5e0", wantErr: true},
+		{name: "не число", input: "abc", wantErr: true},
+		{name: "бесконечность", input: "Inf", wantErr: true},
+		{name: "NaN", input: "NaN", wantErr: true},
+		{name: "слишком большое целое", input: "9999999999999999999", wantErr: true},
+		{name: "слишком большое в экспоненциальной записи", input: "1e19", wantErr: true},
+		{name: "пустая строка", input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := ParseCount(tt.input)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Zero(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingDataScientificSteps() {
+	want, err := TrainingData("5000,Ходьба,1h", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	for _, input := range []string{"5e3,Ходьба,1h", "5000.0,Ходьба,1h"} {
+		got, err := TrainingData(input, 75.0, 1.75)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), want, got)
+	}
+
+	_, err = TrainingData("5000.5,Ходьба,1h", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrInvalidSteps)
+	assert.ErrorContains(suite.T(), err, `value "5000.5" is not a whole number`)
+}
@@ -0,0 +1,54 @@
+package spentcalories
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// distanceSuffix - суффикс первого поля строки с данными о тренировке,
+// который означает, что вместо количества шагов указана дистанция в километрах.
+const distanceSuffix = "km"
+
+// segmentLength возвращает длину в метрах, соответствующую одному шагу, обороту педалей
+// или бассейну для указанного типа активности. Возвращает 0 для неизвестного типа активности.
+func segmentLength(activity string, height float64) float64 {
+	switch activity {
+	case running, walking:
+		return defaultCalculator.stepLengthCoefficient(activity) * height
+	case cycling:
+		return cyclingLenRevolution
+	case swimming:
+		return PoolLengthM
+	case stairs:
+		return LenStep
+	default:
+		return 0.0
+	}
+}
+
+// countForDistance переводит дистанцию в километрах в количество шагов, оборотов педалей
+// или бассейнов для указанного типа активности, округляя результат вверх.
+// Для шагов длина шага определяется по росту height в метрах, как в StepsForDistance,
+// а если рост не положителен - берётся средняя длина шага LenStep.
+// Возвращает ошибку, если дистанция не положительна или бесконечна или тип активности неизвестен.
+func countForDistance(activity string, distanceKm, height float64) (int, error) {
+	if !(distanceKm > 0.0) || math.IsInf(distanceKm, 1) {
+		return 0, fmt.Errorf("%w: distance must be a positive finite number, got: %f", ErrNonPositiveSteps, distanceKm)
+	}
+
+	if !slices.Contains(activities, activity) {
+		return 0, unknownActivityError(activity)
+	}
+
+	segment := segmentLength(activity, height)
+	if (activity == running || activity == walking) && height <= 0.0 {
+		segment = LenStep
+	}
+
+	if segment <= 0.0 {
+		return 0, fmt.Errorf("segment length must be greater than zero, got: %f", segment)
+	}
+
+	return int(math.Ceil(distanceKm*float64(MInKm)/segment - stepsEpsilon)), nil
+}
@@ -0,0 +1,100 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingDistance() {
+	tests := []struct {
+		name      string
+		input     string
+		sep       rune
+		wantCount int
+		wantErr   error
+	}{
+		{
+			name:      "бег - дистанция со средней длиной шага",
+			input:     "3.2km,Бег,30m",
+			sep:       ',',
+			wantCount: 4924,
+		},
+		{
+			name:      "плавание - дистанция в бассейнах",
+			input:     "1km,Плавание,30m",
+			sep:       ',',
+			wantCount: 40,
+		},
+		{
+			name:      "велоспорт - дистанция в оборотах педалей",
+			input:     "10km,Велоспорт,30m",
+			sep:       ',',
+			wantCount: 2000,
+		},
+		{
+			name:      "десятичная запятая при другом разделителе",
+			input:     "3,2km;Бег;30m",
+			sep:       ';',
+			wantCount: 4924,
+		},
+		{
+			name:      "целое количество шагов",
+			input:     "5000,Бег,30m",
+			sep:       ',',
+			wantCount: 5000,
+		},
+		{
+			name:    "дробное количество шагов без суффикса",
+			input:   "3.2,Бег,30m",
+			sep:     ',',
+			wantErr: ErrInvalidSteps,
+		},
+		{
+			name:    "некорректная дистанция",
+			input:   "abckm,Бег,30m",
+			sep:     ',',
+			wantErr: ErrInvalidSteps,
+		},
+		{
+			name:    "отрицательная дистанция",
+			input:   "-1km,Бег,30m",
+			sep:     ',',
+			wantErr: ErrNonPositiveSteps,
+		},
+		{
+			name:    "бесконечная дистанция",
+			input:   "infkm,Бег,30m",
+			sep:     ',',
+			wantErr: ErrNonPositiveSteps,
+		},
+		{
+			name:    "неизвестный тип тренировки",
+			input:   "3.2km,Йога,30m",
+			sep:     ',',
+			wantErr: ErrUnknownActivity,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			count, _, _, err := ParseTrainingSep(tt.input, tt.sep)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(suite.T(), err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.wantCount, count)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingDataDistance() {
+	got, err := TrainingData("3.2km,Бег,30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 3.2, got.DistanceKm, 0.001)
+
+	want, err := TrainingData("2814,Бег,30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+}
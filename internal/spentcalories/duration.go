@@ -0,0 +1,126 @@
+package spentcalories
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// durationUnits сопоставляет словесные единицы измерения времени с их продолжительностью.
+var durationUnits = map[string]time.Duration{
+	"min":     time.Minute,
+	"mins":    time.Minute,
+	"minute":  time.Minute,
+	"minutes": time.Minute,
+	"мин":     time.Minute,
+	"hour":    time.Hour,
+	"hours":   time.Hour,
+	"ч":       time.Hour,
+	"час":     time.Hour,
+	"часа":    time.Hour,
+	"часов":   time.Hour,
+}
+
+// ParseDuration разбирает продолжительность активности.
+// Помимо формата time.ParseDuration ("30m", "1h30m", "1.5h") принимает:
+//   - "ЧЧ:ММ:СС" и "ЧЧ:ММ" (например, "1:30:00" или "0:45")
+//   - число и словесную единицу измерения, в том числе через пробел
+//     (например, "30 min", "1.5 hours", "45 мин", "2 ч")
+//
+// Возвращает продолжительность или ошибку time.ParseDuration, если строка не подходит ни под один формат.
+func ParseDuration(s string) (time.Duration, error) {
+	duration, err := time.ParseDuration(s)
+	if err == nil {
+		return duration, nil
+	}
+
+	if d, ok := parseClockDuration(s); ok {
+		return d, nil
+	}
+
+	if d, ok := parseUnitDuration(s); ok {
+		return d, nil
+	}
+
+	return 0, err
+}
+
+// parseClockDuration разбирает продолжительность в формате "ЧЧ:ММ:СС" или "ЧЧ:ММ".
+func parseClockDuration(s string) (time.Duration, bool) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 && len(parts) != 3 {
+		return 0, false
+	}
+
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+
+	var duration time.Duration
+	for i, part := range parts {
+		if part == "" || strings.Trim(part, "0123456789") != "" {
+			return 0, false
+		}
+
+		value, err := strconv.Atoi(part)
+		if err != nil || (i > 0 && value >= 60) {
+			return 0, false
+		}
+
+		if time.Duration(value) > (math.MaxInt64-duration)/units[i] {
+			return 0, false
+		}
+
+		duration += time.Duration(value) * units[i]
+	}
+
+	return duration, true
+}
+
+// parseUnitDuration разбирает продолжительность в формате "число единица", например "30 min".
+func parseUnitDuration(s string) (time.Duration, bool) {
+	number := s[:len(s)-len(strings.TrimLeft(s, "0123456789."))]
+	if number == "" {
+		return 0, false
+	}
+
+	unit, ok := durationUnits[strings.ToLower(strings.TrimSpace(s[len(number):]))]
+	if !ok {
+		return 0, false
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil || value*float64(unit) >= math.MaxInt64 {
+		return 0, false
+	}
+
+	return time.Duration(value * float64(unit)), true
+}
+
+// FormatDuration форматирует продолжительность в часах и минутах для пользователя,
+// например "30 мин", "2 ч" или "1 ч 15 мин". Продолжительность округляется до минуты.
+func FormatDuration(d time.Duration) string {
+	return formatDuration(d, "ч", "мин")
+}
+
+// formatDuration форматирует продолжительность в часах и минутах с подписями hour и minute.
+// Часы не выводятся для продолжительности меньше часа, минуты - для целого количества часов.
+func formatDuration(d time.Duration, hour, minute string) string {
+	d = d.Round(time.Minute)
+
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+
+	hours, minutes := int64(d/time.Hour), int64(d%time.Hour/time.Minute)
+
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%s%d %s", sign, minutes, minute)
+	case minutes == 0:
+		return fmt.Sprintf("%s%d %s", sign, hours, hour)
+	default:
+		return fmt.Sprintf("%s%d %s %d %s", sign, hours, hour, minutes, minute)
+	}
+}
@@ -0,0 +1,91 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestParseDuration() {
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		// Формат time.ParseDuration
+		{name: "минуты", input: "30m", want: 30 * time.Minute},
+		{name: "часы и минуты", input: "1h30m", want: 90 * time.Minute},
+		{name: "дробные часы", input: "1.5h", want: 90 * time.Minute},
+		// Формат ЧЧ:ММ:СС
+		{name: "часы, минуты и секунды", input: "1:30:00", want: 90 * time.Minute},
+		{name: "с ведущим нулём", input: "00:45:30", want: 45*time.Minute + 30*time.Second},
+		{name: "часы и минуты через двоеточие", input: "0:45", want: 45 * time.Minute},
+		{name: "минуты больше 59", input: "1:60:00", wantErr: true},
+		{name: "пустая часть", input: "1::00", wantErr: true},
+		{name: "знак в части", input: "+1:30:00", wantErr: true},
+		{name: "слишком много частей", input: "1:2:3:4", wantErr: true},
+		{name: "переполнение часов", input: "9999999999:00", wantErr: true},
+		// Число и словесная единица
+		{name: "минуты через пробел", input: "30 min", want: 30 * time.Minute},
+		{name: "минуты полностью", input: "45 minutes", want: 45 * time.Minute},
+		{name: "минуты по-русски", input: "45 мин", want: 45 * time.Minute},
+		{name: "часы через пробел", input: "2 hours", want: 2 * time.Hour},
+		{name: "дробные часы через пробел", input: "1.5 hours", want: 90 * time.Minute},
+		{name: "часы по-русски", input: "2 ч", want: 2 * time.Hour},
+		{name: "единица без пробела", input: "30min", want: 30 * time.Minute},
+		{name: "единица в верхнем регистре", input: "30 MIN", want: 30 * time.Minute},
+		{name: "неизвестная единица", input: "1.5 days", wantErr: true},
+		{name: "пропущена единица", input: "30", wantErr: true},
+		{name: "пропущено число", input: "min", wantErr: true},
+		{name: "отрицательное значение", input: "-5 min", wantErr: true},
+		{name: "переполнение единиц", input: "99999999999 hours", wantErr: true},
+		{name: "пробел внутри формата Go", input: "1 h30m", wantErr: true},
+		{name: "пустая строка", input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := ParseDuration(tt.input)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), time.Duration(0), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingExtendedDuration() {
+	steps, activity, duration, err := parseTraining("5000,Бег,0:30:00")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 5000, steps)
+	assert.Equal(suite.T(), "Бег", activity)
+	assert.Equal(suite.T(), 30*time.Minute, duration)
+}
+
+func (suite *SpentCaloriesTestSuite) TestFormatDuration() {
+	tests := []struct {
+		name  string
+		input time.Duration
+		want  string
+	}{
+		{name: "только минуты", input: 30 * time.Minute, want: "30 мин"},
+		{name: "часы и минуты", input: 75 * time.Minute, want: "1 ч 15 мин"},
+		{name: "целые часы", input: 2 * time.Hour, want: "2 ч"},
+		{name: "округление секунд", input: 29*time.Minute + 45*time.Second, want: "30 мин"},
+		{name: "ноль", input: 0, want: "0 мин"},
+		{name: "отрицательная", input: -90 * time.Minute, want: "-1 ч 30 мин"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, FormatDuration(tt.input))
+		})
+	}
+}
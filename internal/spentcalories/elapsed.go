@@ -0,0 +1,86 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// CaloriesAtElapsed рассчитывает количество калорий, потраченных к моменту elapsed от начала тренировки.
+// Считается, что шаги набираются равномерно, поэтому результат пропорционален доле прошедшего времени.
+// Принимает:
+//   - steps: общее количество шагов за тренировку (должно быть > 0)
+//   - weight: вес пользователя в килограммах (должен быть > 0)
+//   - height: рост пользователя в метрах (должен быть > 0)
+//   - total: общая продолжительность тренировки (должна быть > 0)
+//   - elapsed: прошедшее время (от 0 до total включительно)
+//   - activity: тип активности
+//
+// При elapsed, равном total, результат совпадает с расчётом для всей тренировки.
+// Для активности "Подъём по лестнице" расход зависит от количества пролётов, которого здесь нет,
+// поэтому возвращается ошибка ErrFlightsRequired.
+// Возвращает количество калорий или ошибку в случае невалидных входных данных.
+func CaloriesAtElapsed(steps int, weight, height float64, total, elapsed time.Duration, activity string) (float64, error) {
+	if elapsed < 0 {
+		return 0.0, fmt.Errorf("elapsed must not be negative, got: %s", elapsed)
+	}
+
+	if elapsed > total {
+		return 0.0, fmt.Errorf("elapsed %s exceeds total duration %s", elapsed, total)
+	}
+
+	calories, err := spentCalories(activity, steps, weight, height, total)
+	if err != nil {
+		return 0.0, err
+	}
+
+	return caloriesShare(calories, total, elapsed), nil
+}
+
+// caloriesShare возвращает часть калорий calories за всю тренировку продолжительностью total,
+// потраченную к моменту elapsed, при равномерном наборе шагов. При elapsed, равном total,
+// возвращает calories без погрешности округления.
+func caloriesShare(calories float64, total, elapsed time.Duration) float64 {
+	if elapsed == total {
+		return calories
+	}
+
+	return calories * float64(elapsed) / float64(total)
+}
+
+// maxTimeSeriesPoints - максимальное количество точек, которое возвращает CalorieTimeSeries.
+const maxTimeSeriesPoints = 10_000
+
+// CalorieTimeSeries рассчитывает накопленное количество калорий через равные промежутки времени,
+// например для графика скорости расхода калорий.
+// Принимает те же параметры, что и TrainingData, и шаг step (должен быть > 0).
+// Калории рассчитываются так же, как в CaloriesAtElapsed, в моменты step, 2*step и так далее;
+// последняя точка всегда соответствует концу тренировки и равна калориям за всю тренировку,
+// даже если продолжительность не кратна шагу.
+// Возвращает ошибку в случае невалидных данных или если точек больше maxTimeSeriesPoints.
+func CalorieTimeSeries(data string, weight, height float64, step time.Duration) ([]float64, error) {
+	if step <= 0 {
+		return nil, fmt.Errorf("step must be greater than zero, got: %s", step)
+	}
+
+	result, err := TrainingData(data, weight, height)
+	if err != nil {
+		return nil, err
+	}
+
+	points := int64(result.Duration / step)
+	if result.Duration%step != 0 {
+		points++
+	}
+
+	if points > maxTimeSeriesPoints {
+		return nil, fmt.Errorf("too many points: %d, max: %d", points, maxTimeSeriesPoints)
+	}
+
+	series := make([]float64, points)
+	for i := range series {
+		elapsed := min(time.Duration(i+1)*step, result.Duration)
+		series[i] = caloriesShare(result.Calories, result.Duration, elapsed)
+	}
+
+	return series, nil
+}
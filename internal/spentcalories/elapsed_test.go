@@ -0,0 +1,165 @@
+package spentcalories
+
+import (
+	"math"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesAtElapsed() {
+	tests := []struct {
+		name     string
+		total    time.Duration
+		elapsed  time.Duration
+		activity string
+		wantCal  float64
+		wantErr  bool
+	}{
+		{
+			name:     "начало тренировки",
+			total:    1 * time.Hour,
+			elapsed:  0,
+			activity: "Бег",
+			wantCal:  0,
+		},
+		{
+			name:     "половина тренировки",
+			total:    1 * time.Hour,
+			elapsed:  30 * time.Minute,
+			activity: "Бег",
+			wantCal:  255.9375,
+		},
+		{
+			name:     "ходьба - четверть тренировки",
+			total:    1 * time.Hour,
+			elapsed:  15 * time.Minute,
+			activity: "Ходьба",
+			wantCal:  44.296875,
+		},
+		{
+			name:     "отрицательное время",
+			total:    1 * time.Hour,
+			elapsed:  -1 * time.Minute,
+			activity: "Бег",
+			wantErr:  true,
+		},
+		{
+			name:     "прошедшее время больше общего",
+			total:    1 * time.Hour,
+			elapsed:  61 * time.Minute,
+			activity: "Бег",
+			wantErr:  true,
+		},
+		{
+			name:     "неизвестный тип тренировки",
+			total:    1 * time.Hour,
+			elapsed:  30 * time.Minute,
+			activity: "Йога",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CaloriesAtElapsed(6000, 75.0, 1.75, tt.total, tt.elapsed, tt.activity)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantCal, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesAtElapsedMatchesTotal() {
+	want, err := RunningSpentCalories(7830, 84.6, 1.87, 2*time.Hour+40*time.Minute)
+	assert.NoError(suite.T(), err)
+
+	got, err := CaloriesAtElapsed(7830, 84.6, 1.87, 2*time.Hour+40*time.Minute, 2*time.Hour+40*time.Minute, "Бег")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+}
+
+func (suite *SpentCaloriesTestSuite) TestCalorieTimeSeries() {
+	tests := []struct {
+		name    string
+		input   string
+		step    time.Duration
+		want    []float64
+		wantErr bool
+	}{
+		{
+			name:  "кратный шаг",
+			input: "6000,Бег,1h00m",
+			step:  15 * time.Minute,
+			want:  []float64{127.96875, 255.9375, 383.90625, 511.875},
+		},
+		{
+			name:  "некратный шаг",
+			input: "6000,Ходьба,1h00m",
+			step:  25 * time.Minute,
+			want:  []float64{73.828125, 147.65625, 177.1875},
+		},
+		{
+			name:  "шаг больше тренировки",
+			input: "6000,Ходьба,1h00m",
+			step:  2 * time.Hour,
+			want:  []float64{177.1875},
+		},
+		{
+			name:  "максимальный шаг",
+			input: "6000,Ходьба,1h00m",
+			step:  time.Duration(math.MaxInt64),
+			want:  []float64{177.1875},
+		},
+		{
+			name:    "нулевой шаг",
+			input:   "6000,Бег,1h00m",
+			step:    0,
+			wantErr: true,
+		},
+		{
+			name:    "слишком много точек",
+			input:   "6000,Бег,1h00m",
+			step:    time.Millisecond,
+			wantErr: true,
+		},
+		{
+			name:    "некорректные данные",
+			input:   "6000,Бег",
+			step:    time.Minute,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CalorieTimeSeries(tt.input, 75.0, 1.75, tt.step)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Nil(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDeltaSlice(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestCalorieTimeSeriesEndsWithTotal() {
+	series, err := CalorieTimeSeries("3000,Бег,37m", 75.0, 1.75, 10*time.Minute)
+	assert.NoError(suite.T(), err)
+
+	result, err := TrainingData("3000,Бег,37m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	assert.Len(suite.T(), series, 4)
+	assert.Equal(suite.T(), result.Calories, series[len(series)-1])
+}
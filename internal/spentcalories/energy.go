@@ -0,0 +1,31 @@
+package spentcalories
+
+// EnergyUnit определяет единицу измерения потраченной энергии в отчёте.
+type EnergyUnit int
+
+// Поддерживаемые единицы энергии.
+const (
+	Kilocalories EnergyUnit = iota // килокалории, используются по умолчанию.
+	Kilojoules                     // килоджоули.
+)
+
+// CaloriesToKilojoules переводит килокалории в килоджоули.
+func CaloriesToKilojoules(kcal float64) float64 {
+	return kcal * kJInKcal
+}
+
+// TrainingInfoKJ формирует информационное сообщение о тренировке,
+// в котором потраченная энергия указана в килоджоулях.
+// Принимает те же параметры, что и TrainingInfo.
+// Возвращает отформатированную строку с информацией о тренировке или ошибку в случае невалидных данных.
+func TrainingInfoKJ(data string, weight, height float64) (string, error) {
+	result, err := TrainingData(data, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	opts := DefaultOptions()
+	opts.Energy = Kilojoules
+
+	return formatTraining(result, opts)
+}
@@ -0,0 +1,53 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesToKilojoules() {
+	assert.InDelta(suite.T(), 4.184, CaloriesToKilojoules(1), 1e-9)
+	assert.InDelta(suite.T(), 836.8, CaloriesToKilojoules(200), 1e-9)
+	assert.Zero(suite.T(), CaloriesToKilojoules(0))
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoKJ() {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "ходьба",
+			input: "6000,Ходьба,1h00m",
+			want: "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\n" +
+				"Скорость: 4.72 км/ч\nТемп: 12.70 мин/км\nСожгли: 741.35 кДж\nКаденс: 100.00 шаг/мин\n",
+		},
+		{
+			name:  "плавание",
+			input: "40,Плавание,30m",
+			want: "Тип тренировки: Плавание\nДлительность: 0.50 ч.\nДистанция: 1.00 км.\n" +
+				"Скорость: 2.00 км/ч\nТемп: 30.00 мин/км\nСожгли: 1255.20 кДж\n",
+		},
+		{
+			name:    "некорректные данные",
+			input:   "6000,Ходьба",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoKJ(tt.input, 75.0, 1.75)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
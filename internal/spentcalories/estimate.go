@@ -0,0 +1,73 @@
+package spentcalories
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// EstimatedCadences содержит каденс в шагах в минуту, по которому TrainingInfoEstimate оценивает
+// продолжительность тренировки без указанной продолжительности. Значения можно переопределить,
+// изменив элементы карты. Для типов активности без каденса продолжительность не оценивается.
+var EstimatedCadences = map[string]float64{
+	running: 160.0,
+	walking: 100.0,
+}
+
+// parseTrainingDefaulting работает как parseTrainingHeight, но также принимает строку
+// без продолжительности в формате "количество_шагов,тип_активности" (например, "5000,Бег").
+// Тогда продолжительность оценивается по каденсу из EstimatedCadences для типа активности,
+// а estimated равен true. Строки с продолжительностью разбираются parseTrainingFlights,
+// поэтому для активности "Подъём по лестнице" возвращается и количество пролётов.
+// Возвращает ошибку, если для типа активности каденс не задан или не положителен.
+func parseTrainingDefaulting(data string, sep rune, height float64) (steps int, activity string,
+	duration time.Duration, flights int, estimated bool, err error) {
+	parts := strings.Split(data, string(sep))
+	if len(parts) != 2 {
+		steps, activity, duration, flights, err = parseTrainingFlights(data, sep, height)
+		return steps, activity, duration, flights, false, err
+	}
+
+	activity = normalizeActivity(parts[1])
+	cadence, ok := EstimatedCadences[activity]
+	if !ok || cadence <= 0.0 {
+		return 0, activity, 0, 0, false, fmt.Errorf("%w: cannot estimate duration for %q, got: %s",
+			ErrInvalidFormat, activity, data)
+	}
+
+	count, err := parseCount(parts[0], activity, sep, height)
+	if err != nil {
+		return 0, activity, 0, 0, false, err
+	}
+
+	duration = time.Duration(float64(count) / cadence * float64(time.Minute))
+	if err := checkTraining(activity, count, duration); err != nil {
+		return 0, activity, 0, 0, false, err
+	}
+
+	return count, activity, duration, 0, true, nil
+}
+
+// TrainingInfoEstimate работает как TrainingInfo, но также принимает данные без продолжительности,
+// например "5000,Бег". Продолжительность тогда оценивается по каденсу из EstimatedCadences,
+// а в отчёте указывается, что она оценена.
+// Возвращает отформатированную строку с информацией о тренировке или ошибку в случае невалидных данных.
+func TrainingInfoEstimate(data string, weight, height float64) (string, error) {
+	if err := checkBody(weight, height); err != nil {
+		return "", err
+	}
+
+	steps, activity, duration, flights, estimated, err := parseTrainingDefaulting(data, Delimiter, height)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := trainingResult(activity, steps, duration, flights, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	result.DurationEstimated = estimated
+
+	return formatTraining(result, DefaultOptions())
+}
@@ -0,0 +1,97 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoEstimate() {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "бег без продолжительности",
+			input: "6000,Бег",
+			want: "Тип тренировки: Бег\nДлительность: 0.62 ч.\nДлительность оценена по количеству шагов\n" +
+				"Дистанция: 6.83 км.\nСкорость: 10.92 км/ч\nТемп: 5.49 мин/км\nСожгли калорий: 511.88\n" +
+				"Каденс: 160.00 шаг/мин\nЗона скорости: лёгкая\n",
+		},
+		{
+			name:  "ходьба без продолжительности",
+			input: "6000,ходьба",
+			want: "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДлительность оценена по количеству шагов\n" +
+				"Дистанция: 4.72 км.\nСкорость: 4.72 км/ч\nТемп: 12.70 мин/км\nСожгли калорий: 177.19\n" +
+				"Каденс: 100.00 шаг/мин\n",
+		},
+		{
+			name:  "продолжительность указана",
+			input: "6000,Ходьба,1h00m",
+			want: "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\n" +
+				"Скорость: 4.72 км/ч\nТемп: 12.70 мин/км\nСожгли калорий: 177.19\nКаденс: 100.00 шаг/мин\n",
+		},
+		{
+			name:  "подъём по лестнице с пролётами",
+			input: "2000,Подъём по лестнице,20m,15",
+			want: "Тип тренировки: Подъём по лестнице\nДлительность: 0.33 ч.\nДистанция: 1.30 км.\n" +
+				"Скорость: 3.90 км/ч\nТемп: 15.38 мин/км\nСожгли калорий: 88.32\n",
+		},
+		{
+			name:    "подъём по лестнице без продолжительности",
+			input:   "2000,Подъём по лестнице",
+			wantErr: true,
+		},
+		{
+			name:    "плавание без продолжительности",
+			input:   "40,Плавание",
+			wantErr: true,
+		},
+		{
+			name:    "некорректные шаги",
+			input:   "abc,Бег",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoEstimate(tt.input, 75.0, 1.75)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoEstimateCadence() {
+	defer func(cadence float64) { EstimatedCadences[running] = cadence }(EstimatedCadences[running])
+	EstimatedCadences[running] = 180
+
+	_, _, duration, _, estimated, err := parseTrainingDefaulting("9000,Бег", ',', 1.75)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), estimated)
+	assert.Equal(suite.T(), 50*time.Minute, duration)
+
+	EstimatedCadences[running] = 0
+
+	_, err = TrainingInfoEstimate("9000,Бег", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrInvalidFormat)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoEstimateStairsMatchesTrainingInfo() {
+	want, err := TrainingInfo("2000,Подъём по лестнице,20m,15", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	got, err := TrainingInfoEstimate("2000,Подъём по лестнице,20m,15", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+}
@@ -0,0 +1,30 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// ETAToDistance рассчитывает время, оставшееся до достижения цели по дистанции при текущей скорости.
+// Принимает пройденную дистанцию и цель в километрах и текущую скорость в км/ч.
+// Если цель уже достигнута, возвращает 0 независимо от скорости.
+// Возвращает ошибку, если дистанция или цель отрицательны или скорость не положительна.
+func ETAToDistance(currentKm, goalKm, currentSpeedKmh float64) (time.Duration, error) {
+	if currentKm < 0 {
+		return 0, fmt.Errorf("current distance must not be negative, got: %f", currentKm)
+	}
+
+	if goalKm < 0 {
+		return 0, fmt.Errorf("goal distance must not be negative, got: %f", goalKm)
+	}
+
+	if currentKm >= goalKm {
+		return 0, nil
+	}
+
+	if currentSpeedKmh <= 0 {
+		return 0, fmt.Errorf("speed must be greater than zero, got: %f", currentSpeedKmh)
+	}
+
+	return time.Duration((goalKm - currentKm) / currentSpeedKmh * float64(time.Hour)), nil
+}
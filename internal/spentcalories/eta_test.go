@@ -0,0 +1,83 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestETAToDistance() {
+	tests := []struct {
+		name      string
+		currentKm float64
+		goalKm    float64
+		speedKmh  float64
+		want      time.Duration
+		wantErr   bool
+	}{
+		{
+			name:      "половина дистанции",
+			currentKm: 5,
+			goalKm:    10,
+			speedKmh:  10,
+			want:      30 * time.Minute,
+		},
+		{
+			name:      "старт",
+			currentKm: 0,
+			goalKm:    21.1,
+			speedKmh:  12,
+			want:      105*time.Minute + 30*time.Second,
+		},
+		{
+			name:      "цель достигнута",
+			currentKm: 10,
+			goalKm:    10,
+			speedKmh:  10,
+			want:      0,
+		},
+		{
+			name:      "цель перевыполнена при нулевой скорости",
+			currentKm: 12,
+			goalKm:    10,
+			speedKmh:  0,
+			want:      0,
+		},
+		{
+			name:      "нулевая скорость",
+			currentKm: 5,
+			goalKm:    10,
+			speedKmh:  0,
+			wantErr:   true,
+		},
+		{
+			name:      "отрицательная дистанция",
+			currentKm: -1,
+			goalKm:    10,
+			speedKmh:  10,
+			wantErr:   true,
+		},
+		{
+			name:      "отрицательная цель",
+			currentKm: 0,
+			goalKm:    -10,
+			speedKmh:  10,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := ETAToDistance(tt.currentKm, tt.goalKm, tt.speedKmh)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Zero(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, float64(time.Millisecond))
+		})
+	}
+}
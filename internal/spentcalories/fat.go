@@ -0,0 +1,23 @@
+package spentcalories
+
+// kcalPerFatGram - энергия, запасённая в одном грамме жировой ткани, в килокалориях.
+// Чистый жир содержит около 9 ккал/г, но жировая ткань примерно на 13-15% состоит из воды
+// и других веществ, поэтому обычно принимается около 7.7 ккал/г (3500 ккал на фунт).
+const kcalPerFatGram = 7.7
+
+// CaloriesToFatGrams переводит потраченные килокалории в приблизительное количество граммов сожжённого жира.
+func CaloriesToFatGrams(kcal float64) float64 {
+	return kcal / kcalPerFatGram
+}
+
+// FatGramsForTraining оценивает количество граммов жира, сожжённого за тренировку.
+// Принимает те же параметры, что и TrainingData, калории переводятся функцией CaloriesToFatGrams.
+// Возвращает количество граммов или ошибку в случае невалидных данных.
+func FatGramsForTraining(data string, weight, height float64) (float64, error) {
+	result, err := TrainingData(data, weight, height)
+	if err != nil {
+		return 0.0, err
+	}
+
+	return CaloriesToFatGrams(result.Calories), nil
+}
@@ -0,0 +1,38 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesToFatGrams() {
+	assert.InDelta(suite.T(), 1.0, CaloriesToFatGrams(7.7), 1e-9)
+	assert.InDelta(suite.T(), 0.0, CaloriesToFatGrams(0), 1e-9)
+}
+
+func (suite *SpentCaloriesTestSuite) TestFatGramsForTraining() {
+	tests := []struct {
+		name    string
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{name: "бег", input: "6000,Бег,1h00m", want: 511.875 / 7.7},
+		{name: "плавание", input: "40,Плавание,30m", want: 300 / 7.7},
+		{name: "некорректные данные", input: "6000,Бег", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := FatGramsForTraining(tt.input, 75.0, 1.75)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
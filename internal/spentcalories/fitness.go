@@ -0,0 +1,52 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// FitnessLevel определяет уровень физической подготовки пользователя.
+type FitnessLevel int
+
+// Поддерживаемые уровни подготовки. При FitnessUnspecified поправка на подготовку не применяется.
+const (
+	FitnessUnspecified FitnessLevel = iota // уровень не указан, используется по умолчанию.
+	Beginner                               // начинающий.
+	Intermediate                           // средний уровень.
+	Advanced                               // продвинутый.
+)
+
+// fitnessMultipliers содержит множители расхода калорий для каждого уровня подготовки.
+// Тренированные люди двигаются экономичнее и тратят на ту же работу немного меньше энергии:
+//   - Beginner: 1.05 - неэкономичная техника увеличивает затраты примерно на 5%;
+//   - Intermediate: 1.0 - базовый уровень, для которого подобраны формулы пакета;
+//   - Advanced: 0.95 - экономичность движений у тренированных людей выше примерно на 5%.
+var fitnessMultipliers = map[FitnessLevel]float64{
+	FitnessUnspecified: 1.0,
+	Beginner:           1.05,
+	Intermediate:       1.0,
+	Advanced:           0.95,
+}
+
+// SpentCaloriesFitness рассчитывает количество потраченных калорий с поправкой на уровень подготовки level.
+// Принимает те же параметры, что и CaloriesPerKm, и уровень подготовки: калории рассчитываются
+// по формуле для указанного типа активности и умножаются на множитель из fitnessMultipliers.
+// С FitnessUnspecified результат совпадает с расчётом без поправки.
+// Возвращает количество калорий или ошибку в случае невалидных входных данных
+// или неизвестного уровня подготовки. Подъём по лестнице не поддерживается: без количества
+// пролётов возвращается ErrFlightsRequired.
+func SpentCaloriesFitness(steps int, weight, height float64, duration time.Duration, activity string,
+	level FitnessLevel,
+) (float64, error) {
+	multiplier, ok := fitnessMultipliers[level]
+	if !ok {
+		return 0.0, fmt.Errorf("unknown fitness level: %d", level)
+	}
+
+	calories, err := spentCalories(activity, steps, weight, height, duration)
+	if err != nil {
+		return 0.0, err
+	}
+
+	return calories * multiplier, nil
+}
@@ -0,0 +1,51 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestSpentCaloriesFitness() {
+	tests := []struct {
+		name     string
+		activity string
+		level    FitnessLevel
+		want     float64
+		wantErr  bool
+		errIs    error
+	}{
+		{name: "уровень не указан", activity: "Ходьба", level: FitnessUnspecified, want: 177.1875},
+		{name: "начинающий", activity: "Ходьба", level: Beginner, want: 186.046875},
+		{name: "средний уровень", activity: "Ходьба", level: Intermediate, want: 177.1875},
+		{name: "продвинутый", activity: "Ходьба", level: Advanced, want: 168.328125},
+		{name: "продвинутый - бег", activity: "Бег", level: Advanced, want: 486.28125},
+		{name: "неизвестный тип тренировки", activity: "Йога", level: Advanced, wantErr: true, errIs: ErrUnknownActivity},
+		{name: "неизвестный уровень", activity: "Ходьба", level: FitnessLevel(42), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := SpentCaloriesFitness(6000, 75.0, 1.75, time.Hour, tt.activity, tt.level)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				if tt.errIs != nil {
+					assert.ErrorIs(suite.T(), err, tt.errIs)
+				}
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestSpentCaloriesFitnessInvalidData() {
+	_, err := SpentCaloriesFitness(0, 75.0, 1.75, time.Hour, "Ходьба", Advanced)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveSteps)
+
+	_, err = SpentCaloriesFitness(2000, 75.0, 1.75, 20*time.Minute, "Подъём по лестнице", Advanced)
+	assert.ErrorIs(suite.T(), err, ErrFlightsRequired)
+}
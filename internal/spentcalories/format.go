@@ -0,0 +1,228 @@
+package spentcalories
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Language определяет язык информационных сообщений.
+type Language int
+
+// Поддерживаемые языки сообщений.
+const (
+	Russian Language = iota // русский язык, используется по умолчанию.
+	English                 // английский язык.
+)
+
+// unitLabels содержит подписи единиц измерения дистанции, скорости и темпа.
+type unitLabels struct {
+	distance string
+	speed    string
+	pace     string
+}
+
+// trainingTemplate описывает отчёт о тренировке на одном языке.
+type trainingTemplate struct {
+	activity   string                    // строка с типом активности.
+	duration   string                    // строка с длительностью в часах.
+	clock      string                    // строка с длительностью в часах и минутах, см. Options.HumanDuration.
+	estimated  string                    // пометка о том, что длительность оценена, а не указана.
+	hour       string                    // подпись часов в длительности в часах и минутах.
+	minute     string                    // подпись минут в длительности в часах и минутах.
+	report     string                    // формат отчёта: дистанция, скорость, темп.
+	units      map[UnitSystem]unitLabels // подписи единиц измерения для каждой системы единиц.
+	energy     map[EnergyUnit]string     // строка с потраченной энергией для каждой единицы энергии.
+	activities map[string]string         // названия типов активности, если они отличаются от исходных.
+	cadence    string                    // строка с каденсом, выводится только для активностей с шагами.
+	lowCadence string                    // предупреждение о низком каденсе бега.
+	zone       string                    // строка с зоной скорости, выводится только для бега.
+	zones      map[string]string         // названия зон скорости по кодам SpeedZone.
+}
+
+// trainingTemplates содержит шаблоны отчёта о тренировке для каждого поддерживаемого языка.
+// Чтобы добавить язык, достаточно добавить константу Language и шаблон для неё.
+var trainingTemplates = map[Language]trainingTemplate{
+	Russian: {
+		activity:  "Тип тренировки: %s\n",
+		duration:  "Длительность: %.*f ч.\n",
+		clock:     "Длительность: %s\n",
+		estimated: "Длительность оценена по количеству шагов\n",
+		hour:      "ч",
+		minute:    "мин",
+		report:    "Дистанция: %.*f %s\nСкорость: %.*f %s\nТемп: %.*f %s\n",
+		units: map[UnitSystem]unitLabels{
+			Metric:   {distance: "км.", speed: "км/ч", pace: "мин/км"},
+			Imperial: {distance: "ми.", speed: "миль/ч", pace: "мин/ми"},
+		},
+		energy: map[EnergyUnit]string{
+			Kilocalories: "Сожгли калорий: %.*f\n",
+			Kilojoules:   "Сожгли: %.*f кДж\n",
+		},
+		cadence:    "Каденс: %.*f шаг/мин\n",
+		lowCadence: "Внимание: каденс ниже %d шаг/мин\n",
+		zone:       "Зона скорости: %s\n",
+		zones: map[string]string{
+			ZoneRecovery: "восстановительная",
+			ZoneEasy:     "лёгкая",
+			ZoneTempo:    "темповая",
+			ZoneInterval: "интервальная",
+		},
+	},
+	English: {
+		activity:  "Workout type: %s\n",
+		duration:  "Duration: %.*f h\n",
+		clock:     "Duration: %s\n",
+		estimated: "Duration estimated from step count\n",
+		hour:      "h",
+		minute:    "min",
+		report:    "Distance: %.*f %s\nSpeed: %.*f %s\nPace: %.*f %s\n",
+		units: map[UnitSystem]unitLabels{
+			Metric:   {distance: "km", speed: "km/h", pace: "min/km"},
+			Imperial: {distance: "mi", speed: "mph", pace: "min/mi"},
+		},
+		energy: map[EnergyUnit]string{
+			Kilocalories: "Calories burned: %.*f\n",
+			Kilojoules:   "Energy burned: %.*f kJ\n",
+		},
+		activities: map[string]string{
+			running:  "Running",
+			walking:  "Walking",
+			cycling:  "Cycling",
+			swimming: "Swimming",
+			stairs:   "Stair climbing",
+		},
+		cadence:    "Cadence: %.*f spm\n",
+		lowCadence: "Warning: cadence below %d spm\n",
+		zone:       "Speed zone: %s\n",
+	},
+}
+
+// formatTraining формирует отчёт о тренировке с параметрами форматирования opts.
+// Возвращает ошибку для неизвестного языка, системы единиц, единицы энергии или отрицательной точности.
+func formatTraining(result TrainingResult, opts Options) (string, error) {
+	var report strings.Builder
+	if err := writeTraining(&report, result, opts); err != nil {
+		return "", err
+	}
+
+	return report.String(), nil
+}
+
+// writeTraining записывает отчёт о тренировке в w с параметрами форматирования opts.
+// Возвращает ошибку для неизвестного языка, системы единиц, единицы энергии или отрицательной точности,
+// а также ошибку записи в w.
+func writeTraining(w io.Writer, result TrainingResult, opts Options) error {
+	tmpl, ok := trainingTemplates[opts.Language]
+	if !ok {
+		return fmt.Errorf("unknown language: %d", opts.Language)
+	}
+
+	labels, ok := tmpl.units[opts.Units]
+	if !ok {
+		return fmt.Errorf("unknown unit system: %d", opts.Units)
+	}
+
+	energyLine, ok := tmpl.energy[opts.Energy]
+	if !ok {
+		return fmt.Errorf("unknown energy unit: %d", opts.Energy)
+	}
+
+	prec := opts.Precision
+	if prec < 0 {
+		return fmt.Errorf("precision must not be negative, got: %d", prec)
+	}
+
+	energyValue := result.Calories
+	if opts.Energy == Kilojoules {
+		energyValue = CaloriesToKilojoules(energyValue)
+	}
+
+	dist, speed := result.DistanceKm, result.SpeedKmh
+	if opts.Units == Imperial {
+		dist, speed = dist/kmInMile, speed/kmInMile
+	}
+
+	activity := result.Activity
+	if name, ok := tmpl.activities[activity]; ok {
+		activity = name
+	}
+
+	if _, err := fmt.Fprintf(w, tmpl.activity, activity); err != nil {
+		return fmt.Errorf("write training info: %w", err)
+	}
+
+	var err error
+	if opts.HumanDuration {
+		_, err = fmt.Fprintf(w, tmpl.clock, formatDuration(result.Duration, tmpl.hour, tmpl.minute))
+	} else {
+		_, err = fmt.Fprintf(w, tmpl.duration, prec, result.Duration.Hours())
+	}
+
+	if err != nil {
+		return fmt.Errorf("write training info: %w", err)
+	}
+
+	if result.DurationEstimated {
+		if _, err := io.WriteString(w, tmpl.estimated); err != nil {
+			return fmt.Errorf("write training info: %w", err)
+		}
+	}
+
+	_, err = fmt.Fprintf(w, tmpl.report, prec, dist, labels.distance,
+		prec, speed, labels.speed, prec, Pace(dist, result.Duration).Minutes(), labels.pace)
+	if err != nil {
+		return fmt.Errorf("write training info: %w", err)
+	}
+
+	if _, err := fmt.Fprintf(w, energyLine, prec, energyValue); err != nil {
+		return fmt.Errorf("write training info: %w", err)
+	}
+
+	if result.Cadence > 0 {
+		if _, err := fmt.Fprintf(w, tmpl.cadence, prec, result.Cadence); err != nil {
+			return fmt.Errorf("write training info: %w", err)
+		}
+
+		if result.Activity == running && result.Cadence < minRunningCadence {
+			if _, err := fmt.Fprintf(w, tmpl.lowCadence, minRunningCadence); err != nil {
+				return fmt.Errorf("write training info: %w", err)
+			}
+		}
+	}
+
+	if result.Activity != running {
+		return nil
+	}
+
+	zone := SpeedZone(result.SpeedKmh)
+	if name, ok := tmpl.zones[zone]; ok {
+		zone = name
+	}
+
+	if _, err := fmt.Fprintf(w, tmpl.zone, zone); err != nil {
+		return fmt.Errorf("write training info: %w", err)
+	}
+
+	return nil
+}
+
+// TrainingInfoLang формирует информационное сообщение о тренировке на выбранном языке.
+// Принимает те же параметры, что и TrainingInfo, и язык сообщения lang.
+// Возвращает отформатированную строку с информацией о тренировке или ошибку
+// в случае невалидных данных или неизвестного языка.
+func TrainingInfoLang(data string, weight, height float64, lang Language) (string, error) {
+	if _, ok := trainingTemplates[lang]; !ok {
+		return "", fmt.Errorf("unknown language: %d", lang)
+	}
+
+	result, err := TrainingData(data, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	opts := DefaultOptions()
+	opts.Language = lang
+
+	return formatTraining(result, opts)
+}
@@ -0,0 +1,75 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoLang() {
+	tests := []struct {
+		name    string
+		input   string
+		lang    Language
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "русский язык совпадает с TrainingInfo",
+			input: "6000,Ходьба,1h00m",
+			lang:  Russian,
+			want: "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\n" +
+				"Скорость: 4.72 км/ч\nТемп: 12.70 мин/км\nСожгли калорий: 177.19\n" +
+				"Каденс: 100.00 шаг/мин\n",
+		},
+		{
+			name:  "английский язык - бег",
+			input: "6000,Бег,1h00m",
+			lang:  English,
+			want: "Workout type: Running\nDuration: 1.00 h\nDistance: 6.83 km\n" +
+				"Speed: 6.83 km/h\nPace: 8.79 min/km\nCalories burned: 511.88\n" +
+				"Cadence: 100.00 spm\nWarning: cadence below 160 spm\nSpeed zone: recovery\n",
+		},
+		{
+			name:  "английский язык - плавание",
+			input: "40,Плавание,30m",
+			lang:  English,
+			want: "Workout type: Swimming\nDuration: 0.50 h\nDistance: 1.00 km\n" +
+				"Speed: 2.00 km/h\nPace: 30.00 min/km\nCalories burned: 300.00\n",
+		},
+		{
+			name:    "неизвестный язык",
+			input:   "6000,Бег,1h00m",
+			lang:    Language(99),
+			wantErr: true,
+		},
+		{
+			name:    "некорректные данные",
+			input:   "6000,Бег",
+			lang:    English,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoLang(tt.input, 75.0, 1.75, tt.lang)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestFormatTrainingTemplatesComplete() {
+	for lang, tmpl := range trainingTemplates {
+		for _, units := range []UnitSystem{Metric, Imperial} {
+			_, ok := tmpl.units[units]
+			assert.True(suite.T(), ok, "для языка %d нет подписей системы единиц %d", lang, units)
+		}
+	}
+}
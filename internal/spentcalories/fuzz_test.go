@@ -0,0 +1,39 @@
+package spentcalories
+
+import (
+	"testing"
+)
+
+func FuzzParseTraining(f *testing.F) {
+	for _, seed := range []string{
+		"6000,Бег,1h00m", "3.2km,Ходьба,30 min", "40,Плавание,0:45", "100,Велоспорт,1:30:00",
+		"9999999999999999999,Бег,1h", "6000,Бег,9999999999:00", "1e9km,Бег,1h", ",,", "",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, data string) {
+		steps, _, duration, err := parseTraining(data)
+		if err != nil {
+			return
+		}
+
+		if steps <= 0 || steps > MaxSteps {
+			t.Errorf("parseTraining(%q): steps %d out of bounds", data, steps)
+		}
+
+		if duration <= 0 || duration > MaxDuration {
+			t.Errorf("parseTraining(%q): duration %s out of bounds", data, duration)
+		}
+	})
+}
+
+func FuzzParseDuration(f *testing.F) {
+	for _, seed := range []string{"30m", "1:30:00", "0:45", "1.5 hours", "45 мин", "99999999999 hours"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		_, _ = ParseDuration(s)
+	})
+}
@@ -0,0 +1,71 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// Константы формулы расчёта калорий по пульсу (Keytel et al., 2005).
+// Формула даёт расход в килоджоулях в минуту, для перевода в килокалории используется kJInKcal.
+const (
+	maleHRIntercept   = -55.0969 // свободный член формулы для мужчин.
+	maleHRCoefficient = 0.6309   // коэффициент при пульсе для мужчин.
+	maleHRWeight      = 0.1988   // коэффициент при весе для мужчин.
+	maleHRAge         = 0.2017   // коэффициент при возрасте для мужчин.
+
+	femaleHRIntercept   = -20.4022 // свободный член формулы для женщин.
+	femaleHRCoefficient = 0.4472   // коэффициент при пульсе для женщин.
+	femaleHRWeight      = -0.1263  // коэффициент при весе для женщин.
+	femaleHRAge         = 0.074    // коэффициент при возрасте для женщин.
+
+	kJInKcal = 4.184 // количество килоджоулей в килокалории.
+)
+
+// Допустимые границы входных данных для расчёта калорий по пульсу.
+const (
+	minHeartRate = 30  // минимальный правдоподобный средний пульс.
+	maxHeartRate = 250 // максимальный правдоподобный средний пульс.
+	maxAge       = 120 // максимальный правдоподобный возраст.
+)
+
+// CaloriesByHeartRate рассчитывает количество потраченных калорий по среднему пульсу
+// с коэффициентами для мужчин и женщин по формуле Keytel et al. (2005).
+// Принимает:
+//   - avgHR: средний пульс за тренировку (от minHeartRate до maxHeartRate)
+//   - weight: вес пользователя в килограммах (должен быть > 0)
+//   - age: возраст пользователя в годах (от 1 до maxAge)
+//   - sex: пол пользователя (Male или Female)
+//   - duration: продолжительность активности (должна быть > 0)
+//
+// При очень низком пульсе формула даёт отрицательное значение, в этом случае возвращается 0.
+// Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных.
+func CaloriesByHeartRate(avgHR int, weight float64, age int, sex Sex, duration time.Duration) (float64, error) {
+	if avgHR < minHeartRate || avgHR > maxHeartRate {
+		return 0.0, fmt.Errorf("heart rate must be between %d and %d, got: %d", minHeartRate, maxHeartRate, avgHR)
+	}
+
+	if weight <= 0.0 {
+		return 0.0, fmt.Errorf("weight must be greater than zero, got: %f", weight)
+	}
+
+	if age <= 0 || age > maxAge {
+		return 0.0, fmt.Errorf("age must be between 1 and %d, got: %d", maxAge, age)
+	}
+
+	if duration <= 0 {
+		return 0.0, fmt.Errorf("%w, got: %s", ErrNonPositiveDuration, duration)
+	}
+
+	var kJPerMin float64
+	switch sex {
+	case Male:
+		kJPerMin = maleHRIntercept + maleHRCoefficient*float64(avgHR) + maleHRWeight*weight + maleHRAge*float64(age)
+	case Female:
+		kJPerMin = femaleHRIntercept + femaleHRCoefficient*float64(avgHR) + femaleHRWeight*weight +
+			femaleHRAge*float64(age)
+	default:
+		return 0.0, fmt.Errorf("sex must be Male or Female, got: %d", sex)
+	}
+
+	return max(kJPerMin/kJInKcal*duration.Minutes(), 0.0), nil
+}
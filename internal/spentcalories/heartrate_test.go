@@ -0,0 +1,126 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesByHeartRate() {
+	tests := []struct {
+		name     string
+		avgHR    int
+		weight   float64
+		age      int
+		sex      Sex
+		duration time.Duration
+		wantCal  float64
+		wantErr  bool
+	}{
+		{
+			name:     "мужчина - час",
+			avgHR:    150,
+			weight:   75.0,
+			age:      30,
+			sex:      Male,
+			duration: time.Hour,
+			wantCal:  867.578,
+		},
+		{
+			name:     "женщина - полчаса",
+			avgHR:    150,
+			weight:   75.0,
+			age:      30,
+			sex:      Female,
+			duration: 30 * time.Minute,
+			wantCal:  282.686,
+		},
+		{
+			name:     "низкий пульс - не меньше нуля",
+			avgHR:    40,
+			weight:   50.0,
+			age:      20,
+			sex:      Male,
+			duration: time.Hour,
+			wantCal:  0,
+		},
+		{
+			name:     "пульс ниже допустимого",
+			avgHR:    20,
+			weight:   75.0,
+			age:      30,
+			sex:      Male,
+			duration: time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "пульс выше допустимого",
+			avgHR:    300,
+			weight:   75.0,
+			age:      30,
+			sex:      Male,
+			duration: time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевой возраст",
+			avgHR:    150,
+			weight:   75.0,
+			age:      0,
+			sex:      Male,
+			duration: time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "неправдоподобный возраст",
+			avgHR:    150,
+			weight:   75.0,
+			age:      150,
+			sex:      Female,
+			duration: time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "пол не указан",
+			avgHR:    150,
+			weight:   75.0,
+			age:      30,
+			sex:      SexUnspecified,
+			duration: time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевой вес",
+			avgHR:    150,
+			weight:   0,
+			age:      30,
+			sex:      Male,
+			duration: time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевая продолжительность",
+			avgHR:    150,
+			weight:   75.0,
+			age:      30,
+			sex:      Male,
+			duration: 0,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CaloriesByHeartRate(tt.avgHR, tt.weight, tt.age, tt.sex, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantCal, got, 0.001)
+		})
+	}
+}
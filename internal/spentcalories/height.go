@@ -0,0 +1,31 @@
+package spentcalories
+
+import (
+	"fmt"
+)
+
+// maxHeightM - максимально правдоподобный рост в метрах. Больший рост скорее всего указан в сантиметрах.
+const maxHeightM = 3.0
+
+// SanitizeHeight проверяет рост h, который функции пакета принимают в метрах.
+// Если рост больше maxHeightM, например 175 вместо 1.75, он скорее всего указан в сантиметрах:
+// тогда возвращается рост, переведённый в метры, и true. Иначе h возвращается без изменений вместе с false.
+func SanitizeHeight(h float64) (float64, bool) {
+	if h > maxHeightM {
+		return h / cmInM, true
+	}
+
+	return h, false
+}
+
+// HeightWarning возвращает предупреждение, если рост height похож на рост в сантиметрах,
+// или nil, если рост правдоподобен. Расчёты при этом не прерываются и рост не исправляется;
+// чтобы исправить его, используйте SanitizeHeight.
+func HeightWarning(height float64) error {
+	corrected, suspicious := SanitizeHeight(height)
+	if !suspicious {
+		return nil
+	}
+
+	return fmt.Errorf("warning: height %.2f looks like centimeters, expected meters (%.2f?)", height, corrected)
+}
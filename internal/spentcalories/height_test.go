@@ -0,0 +1,52 @@
+package spentcalories
+
+import (
+	"bytes"
+	"log"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestSanitizeHeight() {
+	tests := []struct {
+		name          string
+		height        float64
+		want          float64
+		wantCorrected bool
+	}{
+		{name: "рост в метрах", height: 1.75, want: 1.75},
+		{name: "рост в сантиметрах", height: 175, want: 1.75, wantCorrected: true},
+		{name: "на границе", height: 3, want: 3},
+		{name: "нулевой рост", height: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, corrected := SanitizeHeight(tt.height)
+
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+			assert.Equal(suite.T(), tt.wantCorrected, corrected)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestHeightWarning() {
+	assert.NoError(suite.T(), HeightWarning(1.75))
+	assert.EqualError(suite.T(), HeightWarning(175),
+		"warning: height 175.00 looks like centimeters, expected meters (1.75?)")
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingDataHeightWarning() {
+	defer func(logger *log.Logger) { Logger = logger }(Logger)
+
+	var buf bytes.Buffer
+	Logger = log.New(&buf, "", 0)
+
+	_, err := TrainingData("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), buf.String())
+
+	_, err = TrainingData("6000,Бег,1h00m", 75.0, 175)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), buf.String(), "looks like centimeters")
+}
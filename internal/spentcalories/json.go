@@ -0,0 +1,105 @@
+package spentcalories
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// trainingJSON описывает представление показателей тренировки в формате JSON.
+type trainingJSON struct {
+	Activity      string  `json:"activity"`
+	DurationHours float64 `json:"duration_hours"`
+	DistanceKm    float64 `json:"distance_km"`
+	SpeedKmh      float64 `json:"speed_kmh"`
+	Calories      float64 `json:"calories"`
+}
+
+// TrainingInfoJSON формирует информацию о тренировке в формате JSON.
+// Принимает те же параметры, что и TrainingInfo.
+// Продолжительность сериализуется в часах, как и в текстовом отчёте.
+// Возвращает JSON-представление показателей тренировки или ошибку разбора данных без изменений.
+func TrainingInfoJSON(data string, weight, height float64) ([]byte, error) {
+	result, err := TrainingData(data, weight, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(trainingJSON{
+		Activity:      result.Activity,
+		DurationHours: result.Duration.Hours(),
+		DistanceKm:    result.DistanceKm,
+		SpeedKmh:      result.SpeedKmh,
+		Calories:      result.Calories,
+	})
+}
+
+// trainingInputJSON описывает данные о тренировке, полученные в формате JSON.
+type trainingInputJSON struct {
+	Steps    int    `json:"steps"`
+	Activity string `json:"activity"`
+	Duration string `json:"duration"`
+	Flights  *int   `json:"flights"` // количество пролётов, только для активности "Подъём по лестнице".
+}
+
+// TrainingInfoFromJSON формирует информационное сообщение о тренировке по данным в формате JSON,
+// например {"steps":5000,"activity":"Бег","duration":"30m"}.
+// Для активности "Подъём по лестнице" обязательно поле flights с количеством пролётов,
+// например {"steps":2000,"activity":"Подъём по лестнице","duration":"20m","flights":15};
+// без него возвращается ErrFlightsRequired. Для остальных типов активности поле flights не допускается.
+// Продолжительность разбирается функцией ParseDuration, поэтому принимаются строки формата Go.
+// Выполняет те же проверки, что и TrainingInfo, и возвращает те же ошибки.
+// Если данные не являются корректным JSON, возвращает ошибку ErrInvalidFormat.
+func TrainingInfoFromJSON(b []byte, weight, height float64) (string, error) {
+	if err := checkBody(weight, height); err != nil {
+		return "", err
+	}
+
+	var input trainingInputJSON
+	if err := json.Unmarshal(b, &input); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidFormat, err)
+	}
+
+	duration, err := ParseDuration(input.Duration)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidDuration, err)
+	}
+
+	activity := normalizeActivity(input.Activity)
+	if err := checkTraining(activity, input.Steps, duration); err != nil {
+		return "", err
+	}
+
+	flights, err := jsonFlights(activity, input.Flights)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := trainingResult(activity, input.Steps, duration, flights, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	return formatTraining(result, DefaultOptions())
+}
+
+// jsonFlights проверяет поле flights данных о тренировке в формате JSON для типа активности activity.
+// Возвращает количество пролётов для активности "Подъём по лестнице" и ноль для остальных типов.
+func jsonFlights(activity string, flights *int) (int, error) {
+	if activity != stairs {
+		if flights != nil {
+			return 0, fmt.Errorf("%w: flights are not supported for %s", ErrInvalidFormat, activity)
+		}
+
+		return 0, nil
+	}
+
+	if flights == nil {
+		return 0, fmt.Errorf("%w: %s expects the flights field", ErrFlightsRequired, activity)
+	}
+
+	if *flights < 0 {
+		return 0, fmt.Errorf("%w: flights must not be negative, got: %d", ErrInvalidFormat, *flights)
+	}
+
+	return *flights, nil
+}
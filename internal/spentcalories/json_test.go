@@ -0,0 +1,133 @@
+package spentcalories
+
+import (
+	"encoding/json"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoJSON() {
+	got, err := TrainingInfoJSON("3000,Бег,30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	var decoded map[string]any
+	assert.NoError(suite.T(), json.Unmarshal(got, &decoded))
+
+	assert.Equal(suite.T(), "Бег", decoded["activity"])
+	assert.InDelta(suite.T(), 0.5, decoded["duration_hours"], 1e-9)
+	assert.InDelta(suite.T(), 3.4125, decoded["distance_km"], 1e-9)
+	assert.InDelta(suite.T(), 6.825, decoded["speed_kmh"], 1e-9)
+	assert.InDelta(suite.T(), 255.9375, decoded["calories"], 1e-9)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoJSONError() {
+	_, wantErr := TrainingData("6000,Ходьба", 75.0, 1.75)
+
+	got, err := TrainingInfoJSON("6000,Ходьба", 75.0, 1.75)
+
+	assert.Nil(suite.T(), got)
+	assert.EqualError(suite.T(), err, wantErr.Error())
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoFromJSON() {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+		wantMsg string
+	}{
+		{
+			name:  "совпадает с TrainingInfo",
+			input: `{"steps":6000,"activity":"Бег","duration":"1h00m"}`,
+		},
+		{
+			name:    "некорректный JSON",
+			input:   `{"steps":6000,`,
+			wantErr: ErrInvalidFormat,
+		},
+		{
+			name:    "нулевое количество шагов",
+			input:   `{"steps":0,"activity":"Бег","duration":"30m"}`,
+			wantErr: ErrNonPositiveSteps,
+		},
+		{
+			name:    "нет продолжительности",
+			input:   `{"steps":5000,"activity":"Бег"}`,
+			wantErr: ErrInvalidDuration,
+		},
+		{
+			name:    "отрицательная продолжительность",
+			input:   `{"steps":5000,"activity":"Бег","duration":"-30m"}`,
+			wantErr: ErrNonPositiveDuration,
+		},
+		{
+			name:    "неизвестный тип тренировки",
+			input:   `{"steps":5000,"activity":"Йога","duration":"30m"}`,
+			wantErr: ErrUnknownActivity,
+			wantMsg: `неизвестный тип тренировки: "Йога", supported: Бег, Ходьба, Велоспорт, Плавание, Подъём по лестнице`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoFromJSON([]byte(tt.input), 75.0, 1.75)
+
+			if tt.wantErr != nil || tt.wantMsg != "" {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				if tt.wantErr != nil {
+					assert.ErrorIs(suite.T(), err, tt.wantErr)
+				}
+				if tt.wantMsg != "" {
+					assert.EqualError(suite.T(), err, tt.wantMsg)
+				}
+				return
+			}
+
+			want, err := TrainingInfo("6000,Бег,1h00m", 75.0, 1.75)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoFromJSONStairs() {
+	want, err := TrainingInfo("2000,Подъём по лестнице,20m,15", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	got, err := TrainingInfoFromJSON(
+		[]byte(`{"steps":2000,"activity":"Подъём по лестнице","duration":"20m","flights":15}`), 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+	assert.Contains(suite.T(), got, "Сожгли калорий: 88.32\n")
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{
+			name:    "нет пролётов",
+			input:   `{"steps":2000,"activity":"Подъём по лестнице","duration":"20m"}`,
+			wantErr: ErrFlightsRequired,
+		},
+		{
+			name:    "отрицательные пролёты",
+			input:   `{"steps":2000,"activity":"Подъём по лестнице","duration":"20m","flights":-1}`,
+			wantErr: ErrInvalidFormat,
+		},
+		{
+			name:    "пролёты для другой активности",
+			input:   `{"steps":2000,"activity":"Ходьба","duration":"20m","flights":15}`,
+			wantErr: ErrInvalidFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoFromJSON([]byte(tt.input), 75.0, 1.75)
+			assert.ErrorIs(suite.T(), err, tt.wantErr)
+			assert.Empty(suite.T(), got)
+		})
+	}
+}
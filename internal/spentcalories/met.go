@@ -0,0 +1,30 @@
+package spentcalories
+
+import (
+	"time"
+)
+
+// METValues содержит метаболические эквиваленты (MET) по умолчанию для каждого типа активности.
+// Значения можно переопределить, изменив элементы карты.
+var METValues = map[string]float64{
+	running:  8.0,
+	walking:  3.5,
+	cycling:  6.0,
+	swimming: 6.0,
+	stairs:   8.8,
+}
+
+// UseMETModel включает расчёт калорий в TrainingData и основанных на ней отчётах
+// по модели MET вместо формул на основе шагов. По умолчанию выключен.
+var UseMETModel = false
+
+// CaloriesByMET рассчитывает количество потраченных калорий по модели MET:
+// калории = MET * вес в килограммах * продолжительность в часах.
+// Возвращает 0, если MET, вес или продолжительность не положительны.
+func CaloriesByMET(met, weight float64, duration time.Duration) float64 {
+	if met <= 0 || weight <= 0 || duration <= 0 {
+		return 0
+	}
+
+	return met * weight * duration.Hours()
+}
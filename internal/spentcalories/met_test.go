@@ -0,0 +1,83 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesByMET() {
+	tests := []struct {
+		name     string
+		met      float64
+		weight   float64
+		duration time.Duration
+		want     float64
+	}{
+		{
+			name:     "бег - час",
+			met:      8.0,
+			weight:   75.0,
+			duration: time.Hour,
+			want:     600,
+		},
+		{
+			name:     "ходьба - полчаса",
+			met:      3.5,
+			weight:   60.0,
+			duration: 30 * time.Minute,
+			want:     105,
+		},
+		{
+			name:     "нулевой MET",
+			met:      0,
+			weight:   75.0,
+			duration: time.Hour,
+			want:     0,
+		},
+		{
+			name:     "нулевой вес",
+			met:      8.0,
+			weight:   0,
+			duration: time.Hour,
+			want:     0,
+		},
+		{
+			name:     "нулевая продолжительность",
+			met:      8.0,
+			weight:   75.0,
+			duration: 0,
+			want:     0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.InDelta(suite.T(), tt.want, CaloriesByMET(tt.met, tt.weight, tt.duration), 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingDataMETModel() {
+	defer func(use bool) { UseMETModel = use }(UseMETModel)
+	defer func(met float64) { METValues[walking] = met }(METValues[walking])
+
+	got, err := TrainingData("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 511.875, got.Calories, 1e-9)
+
+	UseMETModel = true
+
+	got, err = TrainingData("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 600, got.Calories, 1e-9)
+
+	METValues[walking] = 4.0
+
+	got, err = TrainingData("3000,Ходьба,30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 150, got.Calories, 1e-9)
+
+	_, err = TrainingData("0,Бег,1h00m", 75.0, 1.75)
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,43 @@
+package spentcalories
+
+// defaultPrecision - количество знаков после запятой в отчёте о тренировке по умолчанию.
+const defaultPrecision = 2
+
+// Options задаёт параметры форматирования отчёта о тренировке.
+// Параметры влияют только на вывод, расчёт показателей от них не зависит.
+type Options struct {
+	Precision int        // количество знаков после запятой (должно быть >= 0).
+	Units     UnitSystem // система единиц измерения дистанции, скорости и темпа.
+	Language  Language   // язык отчёта.
+	Energy    EnergyUnit // единица измерения потраченной энергии.
+
+	// HumanDuration включает вывод длительности в часах и минутах, например "1 ч 15 мин",
+	// вместо дробного количества часов, например "1.25 ч.".
+	HumanDuration bool
+}
+
+// DefaultOptions возвращает параметры, с которыми формирует отчёт TrainingInfo:
+// два знака после запятой, метрическая система, русский язык, килокалории
+// и длительность в дробных часах.
+func DefaultOptions() Options {
+	return Options{
+		Precision: defaultPrecision,
+		Units:     Metric,
+		Language:  Russian,
+		Energy:    Kilocalories,
+	}
+}
+
+// TrainingInfoOpts формирует информационное сообщение о тренировке с параметрами форматирования opts.
+// Принимает те же данные о тренировке и пользователе, что и TrainingInfo; вес и рост
+// всегда указываются в метрической системе, opts.Units влияет только на вывод.
+// С параметрами DefaultOptions результат совпадает с TrainingInfo.
+// Возвращает отформатированную строку или ошибку в случае невалидных данных или параметров.
+func TrainingInfoOpts(data string, weight, height float64, opts Options) (string, error) {
+	result, err := TrainingData(data, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	return formatTraining(result, opts)
+}
@@ -0,0 +1,85 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoOpts() {
+	withPrecision := func(precision int) Options {
+		opts := DefaultOptions()
+		opts.Precision = precision
+		return opts
+	}
+
+	tests := []struct {
+		name    string
+		opts    Options
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "параметры по умолчанию",
+			opts: DefaultOptions(),
+			want: "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\n" +
+				"Темп: 12.70 мин/км\nСожгли калорий: 177.19\nКаденс: 100.00 шаг/мин\n",
+		},
+		{
+			name: "целые числа",
+			opts: withPrecision(0),
+			want: "Тип тренировки: Ходьба\nДлительность: 1 ч.\nДистанция: 5 км.\nСкорость: 5 км/ч\n" +
+				"Темп: 13 мин/км\nСожгли калорий: 177\nКаденс: 100 шаг/мин\n",
+		},
+		{
+			name: "четыре знака",
+			opts: withPrecision(4),
+			want: "Тип тренировки: Ходьба\nДлительность: 1.0000 ч.\nДистанция: 4.7250 км.\nСкорость: 4.7250 км/ч\n" +
+				"Темп: 12.6984 мин/км\nСожгли калорий: 177.1875\nКаденс: 100.0000 шаг/мин\n",
+		},
+		{
+			name: "длительность в часах и минутах",
+			opts: Options{Precision: 2, HumanDuration: true},
+			want: "Тип тренировки: Ходьба\nДлительность: 1 ч\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\n" +
+				"Темп: 12.70 мин/км\nСожгли калорий: 177.19\nКаденс: 100.00 шаг/мин\n",
+		},
+		{
+			name: "длительность в часах и минутах на английском",
+			opts: Options{Precision: 2, Language: English, HumanDuration: true},
+			want: "Workout type: Walking\nDuration: 1 h\nDistance: 4.72 km\nSpeed: 4.72 km/h\n" +
+				"Pace: 12.70 min/km\nCalories burned: 177.19\nCadence: 100.00 spm\n",
+		},
+		{
+			name:    "отрицательная точность",
+			opts:    withPrecision(-1),
+			wantErr: true,
+		},
+		{
+			name:    "неизвестный язык",
+			opts:    Options{Precision: 2, Language: Language(42)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoOpts("6000,Ходьба,1h00m", 75.0, 1.75, tt.opts)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoOptsDefaultMatchesTrainingInfo() {
+	want, err := TrainingInfo("3000,Бег,30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	got, err := TrainingInfoOpts("3000,Бег,30m", 75.0, 1.75, DefaultOptions())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+}
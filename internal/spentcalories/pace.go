@@ -0,0 +1,82 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// Pace рассчитывает темп - время, затрачиваемое на один километр дистанции.
+// Принимает дистанцию в километрах и продолжительность активности.
+// Возвращает 0, если дистанция или продолжительность не положительны.
+func Pace(distanceKm float64, duration time.Duration) time.Duration {
+	if distanceKm <= 0 || duration <= 0 {
+		return 0
+	}
+
+	return time.Duration(float64(duration) / distanceKm)
+}
+
+// distanceCaloriesCoefficient возвращает коэффициент, на который умножается результат
+// RunningCaloriesByDistance для типа активности activity, и false для неизвестного типа активности.
+func distanceCaloriesCoefficient(activity string) (float64, bool) {
+	switch activity {
+	case running:
+		return 1.0, true
+	case walking:
+		return defaultCalculator.WalkingCoefficient, true
+	case cycling:
+		return cyclingCaloriesCoefficient, true
+	case swimming:
+		return swimmingCaloriesCoefficient, true
+	case stairs:
+		return walkingCaloriesCoefficient, true
+	default:
+		return 0.0, false
+	}
+}
+
+// TrainingInfoFromPace формирует информационное сообщение о тренировке, для которой известны
+// только дистанция и темп, например "5 км в темпе 5:00 мин/км".
+// Принимает:
+//   - distanceKm: дистанция в километрах (должна быть > 0)
+//   - paceMinPerKm: темп в минутах на километр (должен быть > 0)
+//   - weight: вес пользователя в килограммах (должен быть > 0)
+//   - activity: тип активности, один из встроенных типов
+//
+// Продолжительность определяется как дистанция, умноженная на темп, а калории рассчитываются
+// по дистанции функцией RunningCaloriesByDistance с коэффициентом типа активности.
+// Возвращает отформатированную строку с информацией о тренировке или ошибку в случае невалидных данных.
+func TrainingInfoFromPace(distanceKm, paceMinPerKm, weight float64, activity string) (string, error) {
+	if distanceKm <= 0.0 {
+		return "", fmt.Errorf("distance must be greater than zero, got: %f", distanceKm)
+	}
+
+	if paceMinPerKm <= 0.0 {
+		return "", fmt.Errorf("pace must be greater than zero, got: %f", paceMinPerKm)
+	}
+
+	activity = normalizeActivity(activity)
+	coefficient, ok := distanceCaloriesCoefficient(activity)
+	if !ok {
+		return "", unknownActivityError(activity)
+	}
+
+	duration := time.Duration(distanceKm * paceMinPerKm * float64(time.Minute))
+	calories, err := RunningCaloriesByDistance(distanceKm, weight, duration)
+	if err != nil {
+		return "", err
+	}
+
+	calories *= coefficient
+	if met, ok := METValues[activity]; UseMETModel && ok {
+		calories = CaloriesByMET(met, weight, duration)
+	}
+
+	return formatTraining(TrainingResult{
+		Activity:   activity,
+		Duration:   duration,
+		DistanceKm: distanceKm,
+		SpeedKmh:   distanceKm / duration.Hours(),
+		Calories:   calories,
+	}, DefaultOptions())
+}
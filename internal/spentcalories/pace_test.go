@@ -0,0 +1,111 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestPace() {
+	tests := []struct {
+		name       string
+		distanceKm float64
+		duration   time.Duration
+		want       time.Duration
+	}{
+		{
+			name:       "пять минут на километр",
+			distanceKm: 10,
+			duration:   50 * time.Minute,
+			want:       5 * time.Minute,
+		},
+		{
+			name:       "пять с половиной минут на километр",
+			distanceKm: 2,
+			duration:   11 * time.Minute,
+			want:       5*time.Minute + 30*time.Second,
+		},
+		{
+			name:       "нулевая дистанция",
+			distanceKm: 0,
+			duration:   30 * time.Minute,
+			want:       0,
+		},
+		{
+			name:       "нулевая продолжительность",
+			distanceKm: 5,
+			duration:   0,
+			want:       0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, Pace(tt.distanceKm, tt.duration))
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoFromPace() {
+	tests := []struct {
+		name         string
+		distanceKm   float64
+		paceMinPerKm float64
+		activity     string
+		want         string
+		wantErr      bool
+	}{
+		{
+			name:         "5 км бега в темпе 5:00",
+			distanceKm:   5,
+			paceMinPerKm: 5,
+			activity:     "Бег",
+			want: "Тип тренировки: Бег\nДлительность: 0.42 ч.\nДистанция: 5.00 км.\n" +
+				"Скорость: 12.00 км/ч\nТемп: 5.00 мин/км\nСожгли калорий: 375.00\nЗона скорости: темповая\n",
+		},
+		{
+			name:         "ходьба",
+			distanceKm:   4,
+			paceMinPerKm: 12,
+			activity:     "ходьба",
+			want: "Тип тренировки: Ходьба\nДлительность: 0.80 ч.\nДистанция: 4.00 км.\n" +
+				"Скорость: 5.00 км/ч\nТемп: 12.00 мин/км\nСожгли калорий: 150.00\n",
+		},
+		{
+			name:         "нулевая дистанция",
+			distanceKm:   0,
+			paceMinPerKm: 5,
+			activity:     "Бег",
+			wantErr:      true,
+		},
+		{
+			name:         "отрицательный темп",
+			distanceKm:   5,
+			paceMinPerKm: -5,
+			activity:     "Бег",
+			wantErr:      true,
+		},
+		{
+			name:         "неизвестный тип активности",
+			distanceKm:   5,
+			paceMinPerKm: 5,
+			activity:     "Йога",
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoFromPace(tt.distanceKm, tt.paceMinPerKm, 75.0, tt.activity)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package spentcalories
+
+import (
+	"slices"
+)
+
+// CaloriePercentile рассчитывает процентильный ранг значения today среди прошлых тренировок history -
+// долю прошлых значений, строго меньших today, в процентах.
+// Например, 80 означает, что сегодня потрачено больше калорий, чем в 80% прошлых тренировок.
+// Слайс history не изменяется. Для пустого history возвращает 0.
+func CaloriePercentile(today float64, history []float64) float64 {
+	if len(history) == 0 {
+		return 0
+	}
+
+	sorted := slices.Clone(history)
+	slices.Sort(sorted)
+
+	below, _ := slices.BinarySearch(sorted, today)
+
+	return float64(below) / float64(len(sorted)) * 100
+}
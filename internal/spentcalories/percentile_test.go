@@ -0,0 +1,60 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCaloriePercentile() {
+	tests := []struct {
+		name    string
+		today   float64
+		history []float64
+		want    float64
+	}{
+		{
+			name:    "больше 80% тренировок",
+			today:   450,
+			history: []float64{500, 300, 200, 400, 350},
+			want:    80,
+		},
+		{
+			name:    "больше всех тренировок",
+			today:   600,
+			history: []float64{500, 300, 200},
+			want:    100,
+		},
+		{
+			name:    "меньше всех тренировок",
+			today:   100,
+			history: []float64{500, 300, 200},
+			want:    0,
+		},
+		{
+			name:    "равные значения не учитываются",
+			today:   300,
+			history: []float64{300, 300, 200, 400},
+			want:    25,
+		},
+		{
+			name:    "пустая история",
+			today:   300,
+			history: nil,
+			want:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.InDelta(suite.T(), tt.want, CaloriePercentile(tt.today, tt.history), 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestCaloriePercentileDoesNotMutateHistory() {
+	history := []float64{500, 300, 200, 400, 350}
+	want := []float64{500, 300, 200, 400, 350}
+
+	CaloriePercentile(450, history)
+
+	assert.Equal(suite.T(), want, history)
+}
@@ -0,0 +1,25 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// CaloriesPerKm рассчитывает количество калорий, потраченных на один километр дистанции.
+// Принимает те же параметры, что и CaloriesAtElapsed, без прошедшего времени:
+// калории и дистанция рассчитываются по формулам для указанного типа активности.
+// Возвращает количество калорий на километр или ошибку в случае невалидных входных данных
+// или нулевой дистанции, а для подъёма по лестнице - ErrFlightsRequired, как и CaloriesAtElapsed.
+func CaloriesPerKm(steps int, weight, height float64, duration time.Duration, activity string) (float64, error) {
+	calories, err := spentCalories(activity, steps, weight, height, duration)
+	if err != nil {
+		return 0.0, err
+	}
+
+	dist := activityDistance(activity, steps, height)
+	if dist <= 0 {
+		return 0.0, fmt.Errorf("distance must be greater than zero, got: %f", dist)
+	}
+
+	return calories / dist, nil
+}
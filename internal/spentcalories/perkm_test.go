@@ -0,0 +1,69 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesPerKm() {
+	tests := []struct {
+		name     string
+		steps    int
+		duration time.Duration
+		activity string
+		want     float64
+		wantErr  bool
+	}{
+		{
+			name:     "бег",
+			steps:    6000,
+			duration: time.Hour,
+			activity: "Бег",
+			want:     75,
+		},
+		{
+			name:     "ходьба",
+			steps:    6000,
+			duration: time.Hour,
+			activity: "Ходьба",
+			want:     37.5,
+		},
+		{
+			name:     "плавание",
+			steps:    40,
+			duration: 30 * time.Minute,
+			activity: "Плавание",
+			want:     300,
+		},
+		{
+			name:     "нулевое количество шагов",
+			steps:    0,
+			duration: time.Hour,
+			activity: "Бег",
+			wantErr:  true,
+		},
+		{
+			name:     "неизвестный тип тренировки",
+			steps:    6000,
+			duration: time.Hour,
+			activity: "Йога",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CaloriesPerKm(tt.steps, 75.0, 1.75, tt.duration, tt.activity)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Zero(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
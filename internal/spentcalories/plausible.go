@@ -0,0 +1,70 @@
+package spentcalories
+
+import (
+	"fmt"
+)
+
+// Границы правдоподобных значений веса и роста взрослого человека.
+// Значения за их пределами не считаются ошибкой, но скорее всего указаны неверно.
+const (
+	minPlausibleWeightKg = 20.0  // минимальный правдоподобный вес в килограммах.
+	maxPlausibleWeightKg = 300.0 // максимальный правдоподобный вес в килограммах.
+	minPlausibleHeightCm = 100.0 // минимальный правдоподобный рост в сантиметрах.
+	maxPlausibleHeightCm = 250.0 // максимальный правдоподобный рост в сантиметрах.
+)
+
+// Warning описывает подозрительное, но допустимое значение параметра пользователя.
+type Warning struct {
+	Field   string  // название параметра: "weight" или "height".
+	Value   float64 // переданное значение.
+	Message string  // описание проблемы.
+}
+
+// String возвращает предупреждение в виде "поле: описание".
+func (w Warning) String() string {
+	return fmt.Sprintf("%s: %s", w.Field, w.Message)
+}
+
+// ValidateProfile проверяет вес weightKg в килограммах и рост heightCm в сантиметрах.
+// Неположительные значения невозможны и возвращаются как ошибка. Значения за пределами
+// правдоподобных границ, например 500 кг или 30 см, возвращаются как предупреждения:
+// расчёты с ними возможны, но данные стоит перепроверить. Если рост не больше maxHeightM,
+// предупреждение подсказывает, что он, вероятно, указан в метрах.
+// Возвращает предупреждения или nil, если значения правдоподобны.
+func ValidateProfile(weightKg, heightCm float64) ([]Warning, error) {
+	if weightKg <= 0.0 {
+		return nil, fmt.Errorf("weight must be greater than zero, got: %f", weightKg)
+	}
+
+	if heightCm <= 0.0 {
+		return nil, fmt.Errorf("height must be greater than zero, got: %f", heightCm)
+	}
+
+	var warnings []Warning
+
+	if weightKg < minPlausibleWeightKg || weightKg > maxPlausibleWeightKg {
+		warnings = append(warnings, Warning{
+			Field: "weight",
+			Value: weightKg,
+			Message: fmt.Sprintf("%.1f kg is outside the plausible range %.0f-%.0f kg",
+				weightKg, minPlausibleWeightKg, maxPlausibleWeightKg),
+		})
+	}
+
+	if heightCm < minPlausibleHeightCm || heightCm > maxPlausibleHeightCm {
+		message := fmt.Sprintf("%.1f cm is outside the plausible range %.0f-%.0f cm",
+			heightCm, minPlausibleHeightCm, maxPlausibleHeightCm)
+		if heightCm <= maxHeightM {
+			message += fmt.Sprintf(", looks like meters (%.0f cm?)", heightCm*cmInM)
+		}
+
+		warnings = append(warnings, Warning{Field: "height", Value: heightCm, Message: message})
+	}
+
+	return warnings, nil
+}
+
+// Validate проверяет вес и рост профиля функцией ValidateProfile.
+func (p Profile) Validate() ([]Warning, error) {
+	return ValidateProfile(p.WeightKg, p.HeightCm)
+}
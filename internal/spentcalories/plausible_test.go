@@ -0,0 +1,60 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestValidateProfile() {
+	tests := []struct {
+		name       string
+		weightKg   float64
+		heightCm   float64
+		wantFields []string
+		wantErr    bool
+	}{
+		{name: "правдоподобные значения", weightKg: 75, heightCm: 175},
+		{name: "границы диапазонов", weightKg: 20, heightCm: 250},
+		{name: "слишком большой вес", weightKg: 500, heightCm: 175, wantFields: []string{"weight"}},
+		{name: "слишком маленький рост", weightKg: 75, heightCm: 30, wantFields: []string{"height"}},
+		{name: "оба значения подозрительны", weightKg: 5, heightCm: 400, wantFields: []string{"weight", "height"}},
+		{name: "нулевой вес", weightKg: 0, heightCm: 175, wantErr: true},
+		{name: "отрицательный рост", weightKg: 75, heightCm: -175, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := ValidateProfile(tt.weightKg, tt.heightCm)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Nil(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+
+			var fields []string
+			for _, w := range got {
+				fields = append(fields, w.Field)
+			}
+			assert.Equal(suite.T(), tt.wantFields, fields)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestValidateProfileMessages() {
+	got, err := ValidateProfile(500, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []Warning{
+		{Field: "weight", Value: 500, Message: "500.0 kg is outside the plausible range 20-300 kg"},
+		{Field: "height", Value: 1.75, Message: "1.8 cm is outside the plausible range 100-250 cm, looks like meters (175 cm?)"},
+	}, got)
+	assert.Equal(suite.T(), "weight: 500.0 kg is outside the plausible range 20-300 kg", got[0].String())
+}
+
+func (suite *SpentCaloriesTestSuite) TestProfileValidate() {
+	got, err := Profile{WeightKg: 75, HeightCm: 30}.Validate()
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), got, 1)
+	assert.Equal(suite.T(), "height", got[0].Field)
+}
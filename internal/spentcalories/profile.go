@@ -0,0 +1,126 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// Sex определяет биологический пол пользователя.
+type Sex int
+
+// Возможные значения пола. При SexUnspecified поправка на пол не применяется.
+const (
+	SexUnspecified Sex = iota // пол не указан.
+	Male                      // мужской пол.
+	Female                    // женский пол.
+)
+
+// Константы поправки расхода калорий на возраст и пол.
+// Подобраны по формуле Миффлина - Сан Жеора для основного обмена:
+// у женщин основной обмен примерно на 10% ниже, а каждый год жизни снижает его примерно на 0.3%.
+const (
+	femaleCaloriesMultiplier = 0.9   // множитель калорий для женщин.
+	referenceAge             = 25    // возраст, начиная с которого применяется поправка на возраст.
+	ageCaloriesDecline       = 0.003 // снижение множителя калорий за каждый год старше referenceAge.
+	minAgeMultiplier         = 0.7   // минимальное значение возрастного множителя.
+)
+
+// cmInM - количество сантиметров в метре.
+const cmInM = 100
+
+// BMI рассчитывает индекс массы тела.
+// Принимает вес в килограммах и рост в сантиметрах, рост переводится в метры перед расчётом.
+// Возвращает индекс массы тела или ошибку, если вес или рост не положительны.
+func BMI(weightKg, heightCm float64) (float64, error) {
+	if weightKg <= 0.0 {
+		return 0.0, fmt.Errorf("weight must be greater than zero, got: %f", weightKg)
+	}
+
+	if heightCm <= 0.0 {
+		return 0.0, fmt.Errorf("height must be greater than zero, got: %f", heightCm)
+	}
+
+	heightM := heightCm / cmInM
+
+	return weightKg / (heightM * heightM), nil
+}
+
+// profileMultiplier рассчитывает множитель калорий для возраста и пола пользователя.
+func profileMultiplier(age int, sex Sex) float64 {
+	multiplier := 1.0
+	if age > referenceAge {
+		multiplier = max(1.0-ageCaloriesDecline*float64(age-referenceAge), minAgeMultiplier)
+	}
+
+	if sex == Female {
+		multiplier *= femaleCaloriesMultiplier
+	}
+
+	return multiplier
+}
+
+// RunningSpentCaloriesProfile рассчитывает количество потраченных при беге калорий с учётом возраста и пола.
+// Результат RunningSpentCalories умножается на поправку profileMultiplier,
+// поэтому при одинаковой нагрузке пользователи старшего возраста тратят меньше калорий.
+// Принимает:
+//   - steps: количество шагов (должно быть > 0)
+//   - weight: вес пользователя в килограммах (должен быть > 0)
+//   - height: рост пользователя в метрах (должен быть > 0)
+//   - duration: продолжительность активности (должна быть > 0)
+//   - age: возраст пользователя в годах (должен быть > 0)
+//   - sex: пол пользователя
+//
+// Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных.
+func RunningSpentCaloriesProfile(steps int, weight, height float64, duration time.Duration,
+	age int, sex Sex) (float64, error) {
+	if age <= 0 {
+		return 0.0, fmt.Errorf("age must be greater than zero, got: %d", age)
+	}
+
+	if sex < SexUnspecified || sex > Female {
+		return 0.0, fmt.Errorf("unknown sex: %d", sex)
+	}
+
+	calories, err := RunningSpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return 0.0, err
+	}
+
+	return calories * profileMultiplier(age, sex), nil
+}
+
+// Profile содержит параметры пользователя, чтобы не передавать вес и рост в каждый вызов.
+// Возраст и пол необязательны и учитываются только в расчётах с поправкой на них,
+// например в методе RunningSpentCalories.
+type Profile struct {
+	WeightKg float64 // вес в килограммах.
+	HeightCm float64 // рост в сантиметрах.
+	Age      int     // возраст в годах, ноль - не указан.
+	Sex      Sex     // пол.
+}
+
+// HeightM возвращает рост пользователя в метрах, в которых его принимают функции пакета.
+func (p Profile) HeightM() float64 {
+	return p.HeightCm / cmInM
+}
+
+// TrainingInfo работает как функция TrainingInfo с весом и ростом пользователя из профиля.
+func (p Profile) TrainingInfo(data string) (string, error) {
+	return TrainingInfo(data, p.WeightKg, p.HeightM())
+}
+
+// TrainingData работает как функция TrainingData с весом и ростом пользователя из профиля.
+func (p Profile) TrainingData(data string) (TrainingResult, error) {
+	return TrainingData(data, p.WeightKg, p.HeightM())
+}
+
+// RunningSpentCalories рассчитывает количество потраченных при беге калорий для пользователя.
+// Если возраст указан, применяется поправка на возраст и пол, как в RunningSpentCaloriesProfile,
+// иначе результат совпадает с функцией RunningSpentCalories.
+func (p Profile) RunningSpentCalories(steps int, duration time.Duration) (float64, error) {
+	if p.Age == 0 {
+		return RunningSpentCalories(steps, p.WeightKg, p.HeightM(), duration)
+	}
+
+	return RunningSpentCaloriesProfile(steps, p.WeightKg, p.HeightM(), duration, p.Age, p.Sex)
+}
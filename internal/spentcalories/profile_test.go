@@ -0,0 +1,185 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestRunningSpentCaloriesProfile() {
+	tests := []struct {
+		name    string
+		steps   int
+		age     int
+		sex     Sex
+		wantCal float64
+		wantErr bool
+	}{
+		{
+			name:    "молодой мужчина - без поправки",
+			steps:   6000,
+			age:     25,
+			sex:     Male,
+			wantCal: 511.875,
+		},
+		{
+			name:    "пол не указан - без поправки",
+			steps:   6000,
+			age:     20,
+			sex:     SexUnspecified,
+			wantCal: 511.875,
+		},
+		{
+			name:    "молодая женщина",
+			steps:   6000,
+			age:     25,
+			sex:     Female,
+			wantCal: 460.6875,
+		},
+		{
+			name:    "мужчина 45 лет",
+			steps:   6000,
+			age:     45,
+			sex:     Male,
+			wantCal: 481.1625,
+		},
+		{
+			name:    "очень пожилой возраст ограничен минимальным множителем",
+			steps:   6000,
+			age:     200,
+			sex:     Male,
+			wantCal: 358.3125,
+		},
+		{
+			name:    "нулевой возраст",
+			steps:   6000,
+			age:     0,
+			sex:     Male,
+			wantErr: true,
+		},
+		{
+			name:    "неизвестный пол",
+			steps:   6000,
+			age:     30,
+			sex:     Sex(7),
+			wantErr: true,
+		},
+		{
+			name:    "ноль шагов",
+			steps:   0,
+			age:     30,
+			sex:     Male,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := RunningSpentCaloriesProfile(tt.steps, 75.0, 1.75, time.Hour, tt.age, tt.sex)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantCal, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestRunningSpentCaloriesProfileOlderBurnLess() {
+	younger, err := RunningSpentCaloriesProfile(6000, 75.0, 1.75, time.Hour, 30, Male)
+	assert.NoError(suite.T(), err)
+
+	older, err := RunningSpentCaloriesProfile(6000, 75.0, 1.75, time.Hour, 60, Male)
+	assert.NoError(suite.T(), err)
+
+	assert.Less(suite.T(), older, younger)
+}
+
+func (suite *SpentCaloriesTestSuite) TestBMI() {
+	tests := []struct {
+		name     string
+		weightKg float64
+		heightCm float64
+		want     float64
+		wantErr  bool
+	}{
+		{name: "нормальный вес", weightKg: 70.0, heightCm: 176.0, want: 22.598},
+		{name: "избыточный вес", weightKg: 84.6, heightCm: 170.0, want: 29.273},
+		{name: "нулевой вес", weightKg: 0, heightCm: 176.0, wantErr: true},
+		{name: "отрицательный рост", weightKg: 70.0, heightCm: -176.0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := BMI(tt.weightKg, tt.heightCm)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 0.001)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestProfileTrainingInfo() {
+	p := Profile{WeightKg: 75.0, HeightCm: 175}
+
+	for _, data := range []string{"6000,Бег,1h00m", "6000,Ходьба,1h00m", "6000,Бег"} {
+		want, wantErr := TrainingInfo(data, 75.0, 1.75)
+		got, err := p.TrainingInfo(data)
+
+		assert.Equal(suite.T(), want, got, data)
+		assert.Equal(suite.T(), wantErr, err, data)
+	}
+
+	result, err := p.TrainingData("6000,Бег,1h00m")
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 511.875, result.Calories, 1e-9)
+}
+
+func (suite *SpentCaloriesTestSuite) TestProfileRunningSpentCalories() {
+	tests := []struct {
+		name    string
+		profile Profile
+		want    float64
+		wantErr bool
+	}{
+		{
+			name:    "возраст не указан",
+			profile: Profile{WeightKg: 75.0, HeightCm: 175, Sex: Female},
+			want:    511.875,
+		},
+		{
+			name:    "с поправкой на возраст и пол",
+			profile: Profile{WeightKg: 75.0, HeightCm: 175, Age: 35, Sex: Female},
+			want:    511.875 * 0.97 * 0.9,
+		},
+		{
+			name:    "отрицательный возраст",
+			profile: Profile{WeightKg: 75.0, HeightCm: 175, Age: -1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := tt.profile.RunningSpentCalories(6000, time.Hour)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
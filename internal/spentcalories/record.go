@@ -0,0 +1,63 @@
+package spentcalories
+
+import (
+	"fmt"
+)
+
+// TrainingMetric определяет показатель тренировки, по которому сравниваются тренировки.
+// Название Metric уже занято системой единиц.
+type TrainingMetric int
+
+// Поддерживаемые показатели тренировки.
+const (
+	MetricDistance TrainingMetric = iota // дистанция в километрах.
+	MetricCalories                       // количество потраченных калорий.
+	MetricSpeed                          // средняя скорость в км/ч.
+)
+
+// value возвращает значение показателя m для рассчитанной тренировки result.
+func (m TrainingMetric) value(result TrainingResult) (float64, error) {
+	switch m {
+	case MetricDistance:
+		return result.DistanceKm, nil
+	case MetricCalories:
+		return result.Calories, nil
+	case MetricSpeed:
+		return result.SpeedKmh, nil
+	default:
+		return 0.0, fmt.Errorf("unknown metric: %d", m)
+	}
+}
+
+// IsPersonalRecord проверяет, является ли тренировка candidate личным рекордом.
+// Принимает строки с данными о тренировках в формате TrainingData, параметры пользователя
+// и показатель metric, по которому сравниваются тренировки.
+// Возвращает true, если показатель candidate строго больше, чем у каждой тренировки из history;
+// при пустой истории любая корректная тренировка считается рекордом.
+// Если candidate или какая-то тренировка из history невалидна, возвращает ошибку с указанием этой тренировки.
+func IsPersonalRecord(candidate string, history []string, weight, height float64, metric TrainingMetric) (bool, error) {
+	result, err := TrainingData(candidate, weight, height)
+	if err != nil {
+		return false, fmt.Errorf("candidate: %w", err)
+	}
+
+	best, err := metric.value(result)
+	if err != nil {
+		return false, err
+	}
+
+	isRecord := true
+	for i, entry := range history {
+		past, err := TrainingData(entry, weight, height)
+		if err != nil {
+			return false, fmt.Errorf("history entry %d: %w", i, err)
+		}
+
+		value, _ := metric.value(past)
+		if value >= best {
+			isRecord = false
+		}
+	}
+
+	return isRecord, nil
+}
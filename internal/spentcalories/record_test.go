@@ -0,0 +1,89 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestIsPersonalRecord() {
+	history := []string{"6000,Ходьба,1h00m", "3000,Бег,30m", "40,Плавание,30m"}
+
+	tests := []struct {
+		name      string
+		candidate string
+		history   []string
+		metric    TrainingMetric
+		want      bool
+		wantErr   string
+	}{
+		{
+			name:      "рекорд по дистанции",
+			candidate: "6000,Бег,1h00m",
+			history:   history,
+			metric:    MetricDistance,
+			want:      true,
+		},
+		{
+			name:      "рекорд по калориям",
+			candidate: "6000,Бег,1h00m",
+			history:   history,
+			metric:    MetricCalories,
+			want:      true,
+		},
+		{
+			name:      "не рекорд по скорости",
+			candidate: "6000,Бег,1h00m",
+			history:   history,
+			metric:    MetricSpeed,
+			want:      false,
+		},
+		{
+			name:      "равное значение не рекорд",
+			candidate: "3000,Бег,30m",
+			history:   history,
+			metric:    MetricDistance,
+			want:      false,
+		},
+		{
+			name:      "пустая история",
+			candidate: "3000,Ходьба,30m",
+			metric:    MetricCalories,
+			want:      true,
+		},
+		{
+			name:      "невалидная тренировка",
+			candidate: "6000,Бег",
+			history:   history,
+			metric:    MetricDistance,
+			wantErr:   "candidate: ",
+		},
+		{
+			name:      "невалидная история",
+			candidate: "6000,Бег,1h00m",
+			history:   []string{"3000,Бег,30m", "abc,Бег,30m"},
+			metric:    MetricDistance,
+			wantErr:   "history entry 1: ",
+		},
+		{
+			name:      "неизвестный показатель",
+			candidate: "6000,Бег,1h00m",
+			history:   history,
+			metric:    TrainingMetric(99),
+			wantErr:   "unknown metric: 99",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := IsPersonalRecord(tt.candidate, tt.history, 75.0, 1.75, tt.metric)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(suite.T(), err, tt.wantErr)
+				assert.False(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
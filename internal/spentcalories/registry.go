@@ -0,0 +1,94 @@
+package spentcalories
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ActivityCalories рассчитывает количество потраченных калорий для пользовательского типа активности.
+// Принимает значение первого поля строки с данными о тренировке, вес в килограммах,
+// рост в метрах и продолжительность тренировки.
+type ActivityCalories func(steps int, weight, height float64, d time.Duration) (float64, error)
+
+// customActivities содержит зарегистрированные пользовательские типы активности.
+var (
+	customActivitiesMu sync.RWMutex
+	customActivities   = make(map[string]ActivityCalories)
+)
+
+// RegisterActivity регистрирует пользовательский тип активности name с функцией расчёта калорий calc.
+// После регистрации строки с этим типом активности обрабатываются TrainingInfo, TrainingData
+// и ValidateTraining. Дистанция и скорость для пользовательских активностей не рассчитываются.
+// Встроенные типы активности всегда имеют приоритет и не могут быть переопределены.
+// Повторная регистрация заменяет функцию расчёта. Безопасна для конкурентного использования.
+// Вызывает панику, если название пустое или совпадает со встроенным типом активности, либо calc равна nil.
+func RegisterActivity(name string, calc ActivityCalories) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		panic("spentcalories: RegisterActivity with empty name")
+	}
+
+	if calc == nil {
+		panic("spentcalories: RegisterActivity with nil calc for " + name)
+	}
+
+	if slices.Contains(activities, normalizeActivity(name)) {
+		panic("spentcalories: RegisterActivity cannot override built-in activity " + name)
+	}
+
+	customActivitiesMu.Lock()
+	defer customActivitiesMu.Unlock()
+
+	customActivities[name] = calc
+}
+
+// customActivity возвращает функцию расчёта калорий для зарегистрированного типа активности.
+func customActivity(activity string) (ActivityCalories, bool) {
+	customActivitiesMu.RLock()
+	defer customActivitiesMu.RUnlock()
+
+	calc, ok := customActivities[activity]
+
+	return calc, ok
+}
+
+// isKnownActivity проверяет, что тип активности встроенный или зарегистрирован RegisterActivity.
+func isKnownActivity(activity string) bool {
+	if slices.Contains(activities, activity) {
+		return true
+	}
+
+	_, ok := customActivity(activity)
+
+	return ok
+}
+
+// SupportedActivities возвращает поддерживаемые типы активности, например для списка выбора в интерфейсе:
+// встроенные типы, за которыми следуют зарегистрированные RegisterActivity типы в алфавитном порядке.
+// Возвращается копия, изменение которой не влияет на пакет.
+func SupportedActivities() []string {
+	customActivitiesMu.RLock()
+	defer customActivitiesMu.RUnlock()
+
+	return append(slices.Clone(activities), slices.Sorted(maps.Keys(customActivities))...)
+}
+
+// customSpentCalories рассчитывает калории для зарегистрированного типа активности.
+// Возвращает ErrUnknownActivity, если тип активности не зарегистрирован.
+func customSpentCalories(activity string, steps int, weight, height float64, duration time.Duration) (float64, error) {
+	calc, ok := customActivity(activity)
+	if !ok {
+		return 0.0, unknownActivityError(activity)
+	}
+
+	calories, err := calc(steps, weight, height, duration)
+	if err != nil {
+		return 0.0, fmt.Errorf("%s: %w", activity, err)
+	}
+
+	return calories, nil
+}
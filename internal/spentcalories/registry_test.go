@@ -0,0 +1,81 @@
+package spentcalories
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// unregisterActivity удаляет пользовательский тип активности, зарегистрированный в тесте.
+func unregisterActivity(name string) {
+	customActivitiesMu.Lock()
+	defer customActivitiesMu.Unlock()
+
+	delete(customActivities, name)
+}
+
+func (suite *SpentCaloriesTestSuite) TestRegisterActivity() {
+	defer unregisterActivity("Гребля")
+
+	RegisterActivity("Гребля", func(steps int, weight, _ float64, d time.Duration) (float64, error) {
+		if steps > 5000 {
+			return 0, errors.New("too many strokes")
+		}
+
+		return weight * d.Hours() * 7, nil
+	})
+
+	got, err := TrainingInfo("3000,Гребля,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Гребля\nДлительность: 1.00 ч.\nДистанция: 0.00 км.\n"+
+		"Скорость: 0.00 км/ч\nТемп: 0.00 мин/км\nСожгли калорий: 525.00\n", got)
+
+	assert.NoError(suite.T(), ValidateTraining("3000,Гребля,1h00m"))
+
+	_, err = TrainingInfo("6000,Гребля,1h00m", 75.0, 1.75)
+	assert.EqualError(suite.T(), err, "Гребля: too many strokes")
+
+	_, err = TrainingInfo("3000,Эллипс,1h00m", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+	assert.ErrorContains(suite.T(), err, "supported: Бег, Ходьба, Велоспорт, Плавание, Подъём по лестнице, Гребля")
+}
+
+func (suite *SpentCaloriesTestSuite) TestRegisterActivityBuiltInPrecedence() {
+	calc := func(int, float64, float64, time.Duration) (float64, error) { return 1, nil }
+
+	assert.Panics(suite.T(), func() { RegisterActivity("бег", calc) })
+	assert.Panics(suite.T(), func() { RegisterActivity(" ", calc) })
+	assert.Panics(suite.T(), func() { RegisterActivity("Гребля", nil) })
+
+	got, err := RunningSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 511.875, got, 1e-9)
+}
+
+func (suite *SpentCaloriesTestSuite) TestSupportedActivities() {
+	assert.Equal(suite.T(), []string{"Бег", "Ходьба", "Велоспорт", "Плавание", "Подъём по лестнице"}, SupportedActivities())
+
+	for _, activity := range SupportedActivities() {
+		_, err := trainingResult(activity, 100, time.Hour, 0, 75.0, 1.75)
+		assert.NoError(suite.T(), err, activity)
+	}
+
+	_, err := spentCalories("Йога", 100, 75.0, 1.75, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+
+	_, err = spentCalories("Подъём по лестнице", 100, 75.0, 1.75, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrFlightsRequired)
+}
+
+func (suite *SpentCaloriesTestSuite) TestSupportedActivitiesIncludesRegistered() {
+	defer unregisterActivity("Гребля")
+
+	RegisterActivity("Гребля", func(int, float64, float64, time.Duration) (float64, error) { return 1, nil })
+
+	activities := SupportedActivities()
+	assert.Equal(suite.T(), []string{"Бег", "Ходьба", "Велоспорт", "Плавание", "Подъём по лестнице", "Гребля"}, activities)
+
+	activities[0] = "Спринт"
+	assert.Equal(suite.T(), "Бег", SupportedActivities()[0])
+}
@@ -0,0 +1,23 @@
+package spentcalories
+
+import (
+	"time"
+)
+
+// restingMET - метаболический эквивалент состояния покоя. Один MET соответствует
+// примерно 1 ккал на килограмм веса в час, что близко к основному обмену (BMR) взрослого человека.
+const restingMET = 1.0
+
+// RestingCalories оценивает количество калорий, которое пользователь весом weightKg
+// потратил бы в покое за время d, по модели MET с restingMET.
+// Возвращает 0, если вес или продолжительность не положительны.
+func RestingCalories(weightKg float64, d time.Duration) float64 {
+	return CaloriesByMET(restingMET, weightKg, d)
+}
+
+// NetActiveCalories возвращает калории, потраченные сверх уровня покоя: из общего количества
+// калорий total за тренировку вычитается оценка RestingCalories за её продолжительность d.
+// Результат не бывает отрицательным.
+func NetActiveCalories(total float64, weightKg float64, d time.Duration) float64 {
+	return max(total-RestingCalories(weightKg, d), 0)
+}
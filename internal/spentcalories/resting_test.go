@@ -0,0 +1,34 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestNetActiveCalories() {
+	tests := []struct {
+		name     string
+		total    float64
+		weightKg float64
+		d        time.Duration
+		want     float64
+	}{
+		{name: "час ходьбы", total: 177.1875, weightKg: 75, d: time.Hour, want: 102.1875},
+		{name: "полчаса", total: 300, weightKg: 80, d: 30 * time.Minute, want: 260},
+		{name: "меньше уровня покоя", total: 50, weightKg: 75, d: time.Hour, want: 0},
+		{name: "нулевая продолжительность", total: 100, weightKg: 75, d: 0, want: 100},
+		{name: "нулевой вес", total: 100, weightKg: 0, d: time.Hour, want: 100},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.InDelta(suite.T(), tt.want, NetActiveCalories(tt.total, tt.weightKg, tt.d), 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestRestingCalories() {
+	assert.InDelta(suite.T(), 75.0, RestingCalories(75, time.Hour), 1e-9)
+	assert.Zero(suite.T(), RestingCalories(-1, time.Hour))
+}
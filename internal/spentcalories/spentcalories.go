@@ -5,8 +5,10 @@
 package spentcalories
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -14,144 +16,661 @@ import (
 
 // Константы, используемые для расчетов.
 const (
-	LenStep                    = 0.65 // средняя длина шага в метрах.
-	MInKm                      = 1000 // количество метров в километре.
-	minInH                     = 60   // количество минут в часе.
-	stepLengthCoefficient      = 0.45 // коэффициент для расчета длины шага на основе роста.
-	walkingCaloriesCoefficient = 0.5  // коэффициент для расчета калорий при ходьбе
+	LenStep                      = 0.65 // средняя длина шага в метрах.
+	MInKm                        = 1000 // количество метров в километре.
+	minInH                       = 60   // количество минут в часе.
+	stepLengthCoefficient        = 0.45 // коэффициент для расчета длины шага на основе роста.
+	runningStepLengthCoefficient = 0.65 // коэффициент для расчета длины шага при беге на основе роста.
+	walkingCaloriesCoefficient   = 0.5  // коэффициент для расчета калорий при ходьбе
+	cyclingLenRevolution         = 5.0  // расстояние в метрах, которое проезжает велосипед за один оборот педалей.
+	cyclingCaloriesCoefficient   = 0.35 // коэффициент для расчета калорий при езде на велосипеде.
+	swimmingCaloriesCoefficient  = 4.0  // коэффициент для расчета калорий при плавании.
+
+	maxCyclingRPM           = 200.0 // максимально правдоподобное количество оборотов педалей в минуту.
+	maxSwimmingSpeedMPerMin = 150.0 // максимально правдоподобная скорость плавания в метрах в минуту.
 )
 
 // Константы, используемые для определения типа активности.
 const (
-	running = "Бег"    // тип активности "Бег".
-	walking = "Ходьба" // тип активности "Ходьба".
+	running  = "Бег"                // тип активности "Бег".
+	walking  = "Ходьба"             // тип активности "Ходьба".
+	cycling  = "Велоспорт"          // тип активности "Велоспорт".
+	swimming = "Плавание"           // тип активности "Плавание".
+	stairs   = "Подъём по лестнице" // тип активности "Подъём по лестнице".
+)
+
+// activities содержит все поддерживаемые типы активности.
+var activities = []string{running, walking, cycling, swimming, stairs}
+
+// countFields содержит названия первого поля строки с данными о тренировке для типов активности,
+// у которых это поле означает не количество шагов.
+var countFields = map[string]string{
+	cycling:  "revolutions",
+	swimming: "laps",
+}
+
+// Logger используется для записи ошибок, которые функции пакета не возвращают вызывающему коду.
+// По умолчанию это стандартный логгер; чтобы отключить запись, присвойте nil
+// или логгер с выводом в io.Discard.
+var Logger = log.Default()
+
+// logError записывает ошибку err в Logger, если он задан.
+func logError(err error) {
+	if Logger != nil {
+		Logger.Println(err)
+	}
+}
+
+// Delimiter задаёт разделитель полей в строках с данными, которые функции пакетов spentcalories
+// и daysteps разбирают без явно переданного разделителя, например в TrainingInfo и daysteps.DayActionInfo.
+// По умолчанию запятая. Если разделитель отличен от запятой, запятая в продолжительности
+// считается десятичным разделителем, как в ParseTrainingSep.
+var Delimiter = ','
+
+// PoolLengthM задаёт длину бассейна в метрах, используемую для активности "Плавание".
+var PoolLengthM = 25.0
+
+// Ошибки разбора и проверки данных о тренировке.
+// Возвращаются обёрнутыми, поэтому проверять их следует через errors.Is.
+var (
+	ErrInvalidFormat       = errors.New("invalid data format")                // неверный формат строки.
+	ErrInvalidSteps        = errors.New("parsing steps failed")               // количество шагов не является числом.
+	ErrNonPositiveSteps    = errors.New("steps must be greater than zero")    // количество шагов не положительно.
+	ErrInvalidDuration     = errors.New("parsing duration failed")            // продолжительность не распознана.
+	ErrNonPositiveDuration = errors.New("duration must be greater than zero") // продолжительность не положительна.
+	ErrImplausibleStepRate = errors.New("implausible step rate")              // слишком много шагов в минуту.
+	ErrActivityMismatch    = errors.New("data does not match activity")       // данные не соответствуют типу активности.
+	ErrUnknownActivity     = errors.New("неизвестный тип тренировки")         // тип активности не поддерживается.
+	ErrTooManySteps        = errors.New("step count exceeds limit")           // количество шагов больше MaxSteps.
+	ErrDurationTooLong     = errors.New("duration exceeds limit")             // продолжительность больше MaxDuration.
+	ErrFlightsRequired     = errors.New("flights are required")               // для подъёма по лестнице не указаны пролёты.
+)
+
+// MaxStepRate задаёт максимально правдоподобное количество шагов в минуту.
+// Данные с большим темпом шагов считаются ошибкой датчика и отклоняются с ErrImplausibleStepRate.
+var MaxStepRate = 400.0
+
+// CheckStepRate проверяет, что количество шагов за указанную продолжительность
+// не превышает MaxStepRate шагов в минуту.
+// Возвращает ошибку ErrImplausibleStepRate, если темп шагов неправдоподобно высок.
+func CheckStepRate(steps int, duration time.Duration) error {
+	if duration <= 0 {
+		return nil
+	}
+
+	if rate := float64(steps) / duration.Minutes(); rate > MaxStepRate {
+		return fmt.Errorf("%w: %.2f steps/min exceeds %.2f", ErrImplausibleStepRate, rate, MaxStepRate)
+	}
+
+	return nil
+}
+
+// Ограничения входных данных, защищающие от переполнения и заведомо абсурдных значений.
+// Данные, превышающие их, отклоняются с ErrTooManySteps и ErrDurationTooLong.
+var (
+	MaxSteps    = 500_000        // максимальное значение первого поля строки: шагов, оборотов или бассейнов.
+	MaxDuration = 24 * time.Hour // максимальная продолжительность активности.
 )
 
+// CheckLimits проверяет, что количество шагов не больше MaxSteps,
+// а продолжительность не больше MaxDuration.
+// Возвращает ошибку ErrTooManySteps или ErrDurationTooLong, если ограничение превышено.
+func CheckLimits(steps int, duration time.Duration) error {
+	if steps > MaxSteps {
+		return fmt.Errorf("%w: %d > %d", ErrTooManySteps, steps, MaxSteps)
+	}
+
+	if duration > MaxDuration {
+		return fmt.Errorf("%w: %s > %s", ErrDurationTooLong, duration, MaxDuration)
+	}
+
+	return nil
+}
+
 // parseTraining разбирает строку с данными о тренировке.
-// Ожидает строку в формате "количество_шагов,тип_активности,продолжительность" (например, "5000,Бег,30m").
+// Ожидает строку в формате "количество_шагов,тип_активности,продолжительность" (например, "5000,Бег,30m"),
+// поля которой разделены Delimiter.
+// Для активности "Велоспорт" первое поле - количество оборотов педалей, для "Плавание" - количество бассейнов.
+// Вместо количества можно указать дистанцию в километрах с суффиксом "km" (например, "3.2km,Бег,30m"),
+// она переводится в количество функцией countForDistance без учёта роста пользователя.
+// Продолжительность разбирается функцией ParseDuration, а тип активности нормализуется normalizeActivity.
+// Значение первого поля проверяется на правдоподобность для указанного типа активности,
+// а значение первого поля и продолжительность - на ограничения CheckLimits.
 // Возвращает количество шагов, тип активности, продолжительность и ошибку в случае невалидных данных.
 func parseTraining(data string) (int, string, time.Duration, error) {
-	parts := strings.Split(data, ",")
+	return parseTrainingSep(data, Delimiter)
+}
+
+// parseTrainingSep разбирает строку с данными о тренировке, поля которой разделены sep.
+// Если разделитель отличен от запятой, запятая в продолжительности считается десятичным
+// разделителем, например "5000;Бег;1,5h".
+// Возвращает количество шагов, тип активности, продолжительность и ошибку в случае невалидных данных.
+func parseTrainingSep(data string, sep rune) (int, string, time.Duration, error) {
+	return parseTrainingHeight(data, sep, 0)
+}
+
+// parseTrainingHeight работает как parseTrainingSep, но переводит дистанцию из первого поля
+// в количество шагов с учётом роста пользователя height в метрах.
+// Если рост не положителен, используется средняя длина шага LenStep.
+// Строку для активности "Подъём по лестнице" отклоняет с ErrFlightsRequired: количество пролётов
+// здесь некуда вернуть, а без него расход калорий занижается. Такие строки разбирает parseTrainingFlights.
+func parseTrainingHeight(data string, sep rune, height float64) (int, string, time.Duration, error) {
+	count, activity, duration, _, err := parseTrainingFlights(data, sep, height)
+	if err != nil {
+		return count, activity, duration, err
+	}
+
+	if activity == stairs {
+		return 0, activity, 0, fmt.Errorf("%w: %s carries the number of flights, use ParseTrainingFlights",
+			ErrFlightsRequired, activity)
+	}
+
+	return count, activity, duration, nil
+}
+
+// parseTrainingFlights работает как parseTrainingHeight, но дополнительно возвращает количество
+// пролётов для активности "Подъём по лестнице". Для неё строка содержит четвёртое поле -
+// количество пролётов (не меньше нуля), например "2000,Подъём по лестнице,20m,15".
+// Для остальных типов активности количество пролётов равно нулю.
+func parseTrainingFlights(data string, sep rune, height float64) (int, string, time.Duration, int, error) {
+	parts := strings.Split(data, string(sep))
+
+	fieldsCount := 3
+	if len(parts) > 1 && normalizeActivity(parts[1]) == stairs {
+		fieldsCount = 4
+	}
 
-	if len(parts) != 3 {
-		return 0, "", 0, fmt.Errorf("invalid data format: %s", data)
+	if len(parts) != fieldsCount {
+		if len(parts) > 1 {
+			activity := normalizeActivity(parts[1])
+			if activity == stairs {
+				return 0, "", 0, 0, fmt.Errorf("%w: %s expects 'steps%cactivity%cduration%cflights', got: %s",
+					ErrInvalidFormat, activity, sep, sep, sep, data)
+			}
+
+			if field, ok := countFields[activity]; ok {
+				return 0, "", 0, 0, fmt.Errorf("%w: %s expects '%s%cactivity%cduration', got: %s",
+					ErrInvalidFormat, activity, field, sep, sep, data)
+			}
+		}
+
+		return 0, "", 0, 0, fmt.Errorf("%w: %s", ErrInvalidFormat, data)
 	}
 
-	stepCount, activity, durationText := parts[0], parts[1], parts[2]
+	stepCount, activity, durationText := parts[0], normalizeActivity(parts[1]), parts[2]
+	if sep != ',' {
+		durationText = strings.ReplaceAll(durationText, ",", ".")
+	}
 
-	count, err := strconv.Atoi(stepCount)
+	count, err := parseCount(stepCount, activity, sep, height)
 	if err != nil {
-		return 0, activity, 0, fmt.Errorf("parsing steps failed: %w", err)
+		return 0, activity, 0, 0, err
 	}
 
-	if count <= 0 {
-		return 0, activity, 0, fmt.Errorf("steps must be greater than zero, got: %d", count)
+	duration, err := ParseDuration(durationText)
+	if err != nil {
+		return 0, activity, 0, 0, fmt.Errorf("%w: %w", ErrInvalidDuration, err)
+	}
+
+	if err := checkTraining(activity, count, duration); err != nil {
+		return 0, activity, 0, 0, err
+	}
+
+	var flights int
+	if activity == stairs {
+		flights, err = parseFlights(strings.TrimSpace(parts[3]))
+		if err != nil {
+			return 0, activity, 0, 0, err
+		}
 	}
 
-	duration, err := time.ParseDuration(durationText)
+	return count, activity, duration, flights, nil
+}
+
+// parseFlights разбирает количество пролётов лестницы из четвёртого поля строки.
+// Возвращает ошибку ErrInvalidFormat, если значение не является целым числом или отрицательно.
+func parseFlights(text string) (int, error) {
+	flights, err := strconv.Atoi(text)
 	if err != nil {
-		return 0, activity, 0, fmt.Errorf("parsing duration failed: %w", err)
+		return 0, fmt.Errorf("%w: flights must be an integer: %w", ErrInvalidFormat, err)
+	}
+
+	if flights < 0 {
+		return 0, fmt.Errorf("%w: flights must not be negative, got: %d", ErrInvalidFormat, flights)
+	}
+
+	return flights, nil
+}
+
+// parseCount разбирает первое поле строки с данными о тренировке: количество шагов, оборотов
+// педалей или бассейнов, либо дистанцию в километрах с суффиксом distanceSuffix.
+// Дистанция переводится в количество функцией countForDistance, а количество разбирается ParseCount.
+func parseCount(text, activity string, sep rune, height float64) (int, error) {
+	if distanceText, ok := strings.CutSuffix(text, distanceSuffix); ok {
+		if sep != ',' {
+			distanceText = strings.ReplaceAll(distanceText, ",", ".")
+		}
+
+		distanceKm, err := strconv.ParseFloat(distanceText, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: distance: %w", ErrInvalidSteps, err)
+		}
+
+		return countForDistance(activity, distanceKm, height)
+	}
+
+	count, err := ParseCount(text)
+	if err != nil {
+		if field, ok := countFields[activity]; ok {
+			return 0, fmt.Errorf("%w: %s for %s must be an integer: %w", ErrInvalidSteps, field, activity, err)
+		}
+
+		return 0, fmt.Errorf("%w: %w", ErrInvalidSteps, err)
+	}
+
+	return count, nil
+}
+
+// checkTraining проверяет уже разобранные данные о тренировке: количество шагов и продолжительность
+// должны быть положительными и не превышать ограничений CheckLimits, а значение первого поля -
+// быть правдоподобным для типа активности.
+// Возвращает те же ошибки, что и parseTraining.
+func checkTraining(activity string, count int, duration time.Duration) error {
+	if count <= 0 {
+		return fmt.Errorf("%w, got: %d", ErrNonPositiveSteps, count)
 	}
 
 	if duration <= 0 {
-		return 0, activity, 0, fmt.Errorf("activity duration must be greater than zero, got: %s", duration)
+		return fmt.Errorf("activity %w, got: %s", ErrNonPositiveDuration, duration)
 	}
 
-	return count, activity, duration, nil
+	if err := checkActivityRate(activity, count, duration); err != nil {
+		return err
+	}
+
+	return CheckLimits(count, duration)
+}
+
+// checkActivityRate проверяет, что значение первого поля строки правдоподобно для типа активности.
+// Для шагов используется CheckStepRate, для оборотов педалей и бассейнов - собственные ограничения,
+// поэтому, например, количество шагов, переданное для плавания, отклоняется с ErrActivityMismatch.
+func checkActivityRate(activity string, count int, duration time.Duration) error {
+	var maxRate float64
+
+	switch activity {
+	case cycling:
+		maxRate = maxCyclingRPM
+	case swimming:
+		if PoolLengthM <= 0 {
+			return nil
+		}
+		maxRate = maxSwimmingSpeedMPerMin / PoolLengthM
+	default:
+		return CheckStepRate(count, duration)
+	}
+
+	if rate := float64(count) / duration.Minutes(); rate > maxRate {
+		return fmt.Errorf("%w: %.2f %s/min for %s exceeds %.2f",
+			ErrActivityMismatch, rate, countFields[activity], activity, maxRate)
+	}
+
+	return nil
+}
+
+// normalizeActivity удаляет пробелы по краям названия активности и, если оно без учёта регистра
+// совпадает с одним из поддерживаемых типов, возвращает название этого типа.
+// Иначе возвращает название без пробелов по краям.
+func normalizeActivity(activity string) string {
+	activity = strings.TrimSpace(activity)
+	for _, known := range activities {
+		if strings.EqualFold(activity, known) {
+			return known
+		}
+	}
+
+	return activity
+}
+
+// ParseTraining разбирает строку с данными о тренировке без расчёта калорий.
+// Ожидает строку в формате "количество_шагов,тип_активности,продолжительность" (например, "5000,Бег,30m").
+// Строки для активности "Подъём по лестнице" отклоняются с ErrFlightsRequired, их разбирает ParseTrainingFlights.
+// Возвращает количество шагов, тип активности, продолжительность и ошибку в случае невалидных данных.
+func ParseTraining(data string) (steps int, activity string, duration time.Duration, err error) {
+	return parseTraining(data)
+}
+
+// ParseTrainingSep работает как ParseTraining, но разделяет поля строки символом sep.
+// Если разделитель отличен от запятой, запятая в продолжительности считается десятичным разделителем.
+func ParseTrainingSep(data string, sep rune) (steps int, activity string, duration time.Duration, err error) {
+	return parseTrainingSep(data, sep)
+}
+
+// ParseTrainingFlights работает как ParseTraining, но также принимает строки для активности
+// "Подъём по лестнице" с четвёртым полем - количеством пролётов, например "2000,Подъём по лестнице,20m,15".
+// Возвращает количество шагов, тип активности, продолжительность, количество пролётов
+// (ноль для остальных типов активности) и ошибку в случае невалидных данных.
+func ParseTrainingFlights(data string) (steps int, activity string, duration time.Duration, flights int, err error) {
+	return parseTrainingFlights(data, Delimiter, 0)
+}
+
+// ValidateTraining проверяет строку с данными о тренировке без расчёта калорий.
+// Выполняет те же проверки формата, шагов, продолжительности и типа активности, что и TrainingData.
+// Возвращает nil, если строка корректна, иначе ту же ошибку, что и TrainingData.
+func ValidateTraining(data string) error {
+	_, activity, _, _, err := parseTrainingFlights(data, Delimiter, 0)
+	if err != nil {
+		return err
+	}
+
+	if !isKnownActivity(activity) {
+		return unknownActivityError(activity)
+	}
+
+	return nil
 }
 
 // distance рассчитывает пройденную дистанцию в километрах.
-// Принимает количество шагов и рост пользователя.
+// Принимает количество шагов, рост пользователя и тип активности, по которому выбирается
+// коэффициент длины шага. Для неизвестного типа активности используется коэффициент по умолчанию.
+// Возвращает дистанцию в километрах.
+func distance(steps int, height float64, activity string) float64 {
+	return defaultCalculator.ActivityDistance(steps, height, activity)
+}
+
+// cyclingDistance рассчитывает дистанцию поездки на велосипеде в километрах.
+// Принимает количество оборотов педалей, длина шага при езде не используется.
+// Возвращает дистанцию в километрах.
+func cyclingDistance(revolutions int) float64 {
+	if revolutions <= 0 {
+		return 0.0
+	}
+
+	return cyclingLenRevolution * float64(revolutions) / float64(MInKm)
+}
+
+// swimmingDistance рассчитывает проплытую дистанцию в километрах.
+// Принимает количество бассейнов и длину бассейна в метрах.
 // Возвращает дистанцию в километрах.
-func distance(steps int, height float64) float64 {
-	if steps <= 0 || height <= 0 {
+func swimmingDistance(laps int, poolLengthM float64) float64 {
+	if laps <= 0 || poolLengthM <= 0 {
 		return 0.0
 	}
 
-	return stepLengthCoefficient * height * float64(steps) / float64(MInKm)
+	return float64(laps) * poolLengthM / float64(MInKm)
 }
 
 // meanSpeed рассчитывает среднюю скорость передвижения в км/ч.
-// Принимает количество шагов, рост пользователя в сантиметрах и продолжительность активности.
+// Принимает количество шагов, рост пользователя в метрах, продолжительность активности
+// и тип активности, по которому выбирается коэффициент длины шага.
 // Возвращает среднюю скорость в километрах в час.
-func meanSpeed(steps int, height float64, duration time.Duration) float64 {
+func meanSpeed(steps int, height float64, duration time.Duration, activity string) float64 {
 	if steps <= 0 || height <= 0 || duration <= 0 {
 		return 0.0
 	}
 
-	return distance(steps, height) / duration.Hours()
+	return distance(steps, height, activity) / duration.Hours()
 }
 
-// TrainingInfo формирует информационное сообщение о тренировке.
+// Distance рассчитывает дистанцию в километрах, пройденную за указанное количество шагов.
+// Длина шага определяется по росту пользователя в метрах.
+// Возвращает 0, если количество шагов или рост не положительны.
+func Distance(steps int, height float64) float64 {
+	return distance(steps, height, "")
+}
+
+// stepsEpsilon - допуск на погрешность вычислений с плавающей точкой при округлении шагов вверх.
+const stepsEpsilon = 1e-9
+
+// StepsForDistance рассчитывает количество шагов, необходимое для прохождения дистанции.
+// Обращает формулу distance: длина шага определяется по росту пользователя в метрах.
+// Результат округляется вверх до целого шага.
+// Возвращает количество шагов или ошибку, если дистанция или рост не положительны.
+func StepsForDistance(distanceKm, height float64) (int, error) {
+	if distanceKm <= 0.0 {
+		return 0, fmt.Errorf("distance must be greater than zero, got: %f", distanceKm)
+	}
+
+	if height <= 0.0 {
+		return 0, fmt.Errorf("height must be greater than zero, got: %f", height)
+	}
+
+	steps := distanceKm * float64(MInKm) / (stepLengthCoefficient * height)
+
+	return int(math.Ceil(steps - stepsEpsilon)), nil
+}
+
+// MeanSpeed рассчитывает среднюю скорость передвижения в км/ч по количеству шагов,
+// росту пользователя в метрах и продолжительности активности.
+// Возвращает 0, если количество шагов, рост или продолжительность не положительны.
+func MeanSpeed(steps int, height float64, duration time.Duration) float64 {
+	return meanSpeed(steps, height, duration, "")
+}
+
+// spentCalories рассчитывает количество потраченных калорий для указанного типа активности.
+// Для типов активности, зарегистрированных RegisterActivity, используется их функция расчёта.
+// Для активности "Подъём по лестнице" расход зависит от количества пролётов, которого здесь нет,
+// поэтому возвращается ErrFlightsRequired, а не заниженный результат.
+// Возвращает ошибку для неизвестного типа активности или невалидных входных данных.
+func spentCalories(activity string, steps int, weight, height float64, duration time.Duration) (float64, error) {
+	switch activity {
+	case running:
+		return RunningSpentCalories(steps, weight, height, duration)
+	case walking:
+		return WalkingSpentCalories(steps, weight, height, duration)
+	case cycling:
+		return CyclingSpentCalories(steps, weight, height, duration)
+	case swimming:
+		return SwimmingSpentCalories(steps, PoolLengthM, weight, duration)
+	case stairs:
+		return 0.0, fmt.Errorf("%w: %s needs the number of flights, use StairsSpentCalories",
+			ErrFlightsRequired, activity)
+	default:
+		return customSpentCalories(activity, steps, weight, height, duration)
+	}
+}
+
+// unknownActivityError возвращает ошибку ErrUnknownActivity с названием активности
+// и списком поддерживаемых типов активности, включая зарегистрированные RegisterActivity.
+func unknownActivityError(activity string) error {
+	return fmt.Errorf("%w: %q, supported: %s", ErrUnknownActivity, activity, strings.Join(SupportedActivities(), ", "))
+}
+
+// activityDistance рассчитывает дистанцию в километрах для указанного типа активности.
+// Возвращает 0 для неизвестного типа активности.
+func activityDistance(activity string, steps int, height float64) float64 {
+	switch activity {
+	case running, walking:
+		return distance(steps, height, activity)
+	case cycling:
+		return cyclingDistance(steps)
+	case swimming:
+		return swimmingDistance(steps, PoolLengthM)
+	case stairs:
+		return stairsDistance(steps)
+	default:
+		return 0.0
+	}
+}
+
+// TrainingResult содержит рассчитанные показатели тренировки.
+type TrainingResult struct {
+	Activity   string        // тип активности.
+	Duration   time.Duration // продолжительность тренировки.
+	DistanceKm float64       // пройденная дистанция в километрах.
+	SpeedKmh   float64       // средняя скорость в километрах в час.
+	Calories   float64       // количество потраченных калорий.
+	Cadence    float64       // каденс в шагах в минуту, ноль для активностей без шагов.
+	Steps      int           // количество шагов для бега, ходьбы и подъёма по лестнице, ноль для остальных активностей.
+
+	// DurationEstimated показывает, что продолжительность не была указана
+	// и оценена по количеству шагов, см. TrainingInfoEstimate.
+	DurationEstimated bool
+}
+
+// TrainingData рассчитывает показатели тренировки.
 // Принимает:
 //   - data: строка с данными о тренировке в формате "количество_шагов,тип_активности,продолжительность"
 //   - weight: вес пользователя в килограммах
-//   - height: рост пользователя в сантиметрах
+//   - height: рост пользователя в метрах
 //
-// Возвращает отформатированную строку с информацией о тренировке или ошибку в случае невалидных данных.
-// Поддерживаемые типы активности: "Бег", "Ходьба".
-func TrainingInfo(data string, weight, height float64) (string, error) {
+// Возвращает показатели тренировки или ошибку в случае невалидных данных.
+// Поддерживаемые типы активности: "Бег", "Ходьба", "Велоспорт", "Плавание", "Подъём по лестнице".
+// Для активности "Велоспорт" вместо шагов передаётся количество оборотов педалей,
+// для активности "Плавание" - количество бассейнов длиной PoolLengthM.
+// Для активности "Подъём по лестнице" строка содержит четвёртое поле - количество пролётов,
+// например "2000,Подъём по лестнице,20m,15".
+// Вместо количества можно указать дистанцию в километрах с суффиксом "km", например "3.2km,Бег,30m".
+// Если включён UseMETModel, калории рассчитываются по модели MET со значениями из METValues.
+func TrainingData(data string, weight, height float64) (TrainingResult, error) {
+	if err := checkBody(weight, height); err != nil {
+		return TrainingResult{}, err
+	}
+
+	steps, activity, duration, flights, err := parseTrainingFlights(data, Delimiter, height)
+	if err != nil {
+		logError(err)
+		return TrainingResult{}, err
+	}
+
+	return trainingResult(activity, steps, duration, flights, weight, height)
+}
+
+// checkBody проверяет, что вес и рост пользователя положительны.
+// Если рост похож на рост в сантиметрах, записывает предупреждение HeightWarning в Logger.
+func checkBody(weight, height float64) error {
 	if weight <= 0.0 {
-		return "", fmt.Errorf("weight must be greater than zero, got: %f", weight)
+		return fmt.Errorf("weight must be greater than zero, got: %f", weight)
 	}
 
 	if height <= 0.0 {
-		return "", fmt.Errorf("height must be greater than zero, got: %f", height)
+		return fmt.Errorf("height must be greater than zero, got: %f", height)
 	}
 
-	steps, activity, duration, err := parseTraining(data)
-	if err != nil {
-		log.Println(err)
-		return "", err
+	if warning := HeightWarning(height); warning != nil {
+		logError(warning)
 	}
 
-	var calories float64
-	switch activity {
-	case running:
-		calories, err = RunningSpentCalories(steps, weight, height, duration)
-	case walking:
-		calories, err = WalkingSpentCalories(steps, weight, height, duration)
-	default:
-		return "", fmt.Errorf("неизвестный тип тренировки")
+	return nil
+}
+
+// trainingResult рассчитывает показатели тренировки по уже разобранным и проверенным данным.
+// Количество пролётов flights учитывается только для активности "Подъём по лестнице".
+// Возвращает ошибку для неизвестного типа активности.
+func trainingResult(activity string, steps int, duration time.Duration, flights int,
+	weight, height float64,
+) (TrainingResult, error) {
+	var (
+		calories float64
+		err      error
+	)
+
+	if activity == stairs {
+		calories, err = StairsSpentCalories(steps, weight, flights, duration)
+	} else {
+		calories, err = spentCalories(activity, steps, weight, height, duration)
 	}
 
 	if err != nil {
-		return "", err
+		return TrainingResult{}, err
+	}
+
+	if met, ok := METValues[activity]; UseMETModel && ok {
+		calories = CaloriesByMET(met, weight, duration)
 	}
 
-	dist, speed := distance(steps, height), meanSpeed(steps, height, duration)
+	dist := activityDistance(activity, steps, height)
+
+	var cadence float64
+	if activity == running || activity == walking {
+		cadence = Cadence(steps, duration)
+	}
+
+	var stepCount int
+	if activity == running || activity == walking || activity == stairs {
+		stepCount = steps
+	}
 
-	return fmt.Sprintf("Тип тренировки: %s\nДлительность: %.2f ч.\n"+
-		"Дистанция: %.2f км.\nСкорость: %.2f км/ч\nСожгли калорий: %.2f\n",
-		activity, duration.Hours(), dist, speed, calories), nil
+	return TrainingResult{
+		Activity:   activity,
+		Duration:   duration,
+		DistanceKm: dist,
+		SpeedKmh:   dist / duration.Hours(),
+		Calories:   calories,
+		Cadence:    cadence,
+		Steps:      stepCount,
+	}, nil
+}
+
+// TrainingInfo формирует информационное сообщение о тренировке.
+// Принимает:
+//   - data: строка с данными о тренировке в формате "количество_шагов,тип_активности,продолжительность"
+//   - weight: вес пользователя в килограммах
+//   - height: рост пользователя в метрах
+//
+// Возвращает отформатированную строку с информацией о тренировке или ошибку в случае невалидных данных.
+// Показатели рассчитываются функцией TrainingData в метрической системе единиц,
+// темп в отчёте указывается в минутах на километр.
+func TrainingInfo(data string, weight, height float64) (string, error) {
+	return TrainingInfoUnits(data, weight, height, Metric)
 }
 
 // RunningSpentCalories рассчитывает количество потраченных калорий при беге.
 // Принимает:
 //   - steps: количество шагов (должно быть > 0)
 //   - weight: вес пользователя в килограммах (должен быть > 0)
-//   - height: рост пользователя в сантиметрах (должен быть > 0)
+//   - height: рост пользователя в метрах (должен быть > 0)
 //   - duration: продолжительность активности (должна быть > 0)
 //
 // Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных.
+// Расчёт выполняется калькулятором с коэффициентами по умолчанию.
 func RunningSpentCalories(steps int, weight, height float64, duration time.Duration) (float64, error) {
-	if steps <= 0 {
-		return 0.0, fmt.Errorf("steps must be greater than zero, got: %d", steps)
+	return defaultCalculator.RunningCalories(steps, weight, height, duration)
+}
+
+// RunningCaloriesByDistance рассчитывает количество потраченных калорий при беге по известной дистанции,
+// например полученной по GPS. Продолжительность переводится в секунды,
+// дальше используется RunningCaloriesBySeconds.
+// Принимает:
+//   - distanceKm: дистанция в километрах (должна быть > 0)
+//   - weight: вес пользователя в килограммах (должен быть > 0)
+//   - duration: продолжительность активности (должна быть > 0)
+//
+// Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных.
+func RunningCaloriesByDistance(distanceKm, weight float64, duration time.Duration) (float64, error) {
+	if distanceKm <= 0.0 {
+		return 0.0, fmt.Errorf("distance must be greater than zero, got: %f", distanceKm)
 	}
 
 	if weight <= 0.0 {
 		return 0.0, fmt.Errorf("weight must be greater than zero, got: %f", weight)
 	}
 
-	if height <= 0.0 {
-		return 0.0, fmt.Errorf("height must be greater than zero, got: %f", height)
+	if duration <= 0 {
+		return 0.0, fmt.Errorf("%w, got: %s", ErrNonPositiveDuration, duration)
 	}
 
-	if duration <= 0 {
-		return 0.0, fmt.Errorf("duration must be greater than zero, got: %s", duration)
+	return RunningCaloriesBySeconds(distanceKm, weight, duration.Seconds())
+}
+
+// RunningCaloriesBySeconds работает как RunningCaloriesByDistance, но принимает продолжительность
+// в секундах seconds (должна быть > 0). Формула вес * скорость * часы, где скорость - дистанция
+// в час, сводится к вес * дистанция, поэтому скорость не вычисляется, а результат не зависит
+// от погрешности перевода секунд в часы, в том числе для очень коротких тренировок.
+// Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных.
+func RunningCaloriesBySeconds(distanceKm, weight, seconds float64) (float64, error) {
+	if distanceKm <= 0.0 {
+		return 0.0, fmt.Errorf("distance must be greater than zero, got: %f", distanceKm)
+	}
+
+	if weight <= 0.0 {
+		return 0.0, fmt.Errorf("weight must be greater than zero, got: %f", weight)
+	}
+
+	if seconds <= 0.0 {
+		return 0.0, fmt.Errorf("%w, got: %fs", ErrNonPositiveDuration, seconds)
 	}
 
-	return (weight * meanSpeed(steps, height, duration) * duration.Minutes()) / minInH, nil
+	return weight * distanceKm, nil
 }
 
 // WalkingSpentCalories рассчитывает количество сожженных калорий при ходьбе.
@@ -159,16 +678,106 @@ func RunningSpentCalories(steps int, weight, height float64, duration time.Durat
 // Принимает:
 //   - steps: количество шагов (должно быть > 0)
 //   - weight: вес пользователя в килограммах (должен быть > 0)
-//   - height: рост пользователя в сантиметрах (должен быть > 0)
+//   - height: рост пользователя в метрах (должен быть > 0)
 //   - duration: продолжительность активности (должна быть > 0)
 //
 // Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных.
+// Расчёт выполняется калькулятором с коэффициентами по умолчанию.
 func WalkingSpentCalories(steps int, weight, height float64, duration time.Duration) (float64, error) {
-	calories, err := RunningSpentCalories(steps, weight, height, duration)
+	return defaultCalculator.WalkingCalories(steps, weight, height, duration)
+}
+
+// CyclingSpentCalories рассчитывает количество потраченных калорий при езде на велосипеде.
+// Дистанция считается по количеству оборотов педалей и cyclingLenRevolution,
+// а к результату применяется коэффициент cyclingCaloriesCoefficient.
+// Принимает:
+//   - steps: количество оборотов педалей (должно быть > 0)
+//   - weight: вес пользователя в килограммах (должен быть > 0)
+//   - height: рост пользователя (должен быть > 0), в расчёте дистанции не используется
+//   - duration: продолжительность активности (должна быть > 0)
+//
+// Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных.
+func CyclingSpentCalories(steps int, weight, height float64, duration time.Duration) (float64, error) {
+	if steps <= 0 {
+		return 0.0, fmt.Errorf("%w, got: %d", ErrNonPositiveSteps, steps)
+	}
+
+	if weight <= 0.0 {
+		return 0.0, fmt.Errorf("weight must be greater than zero, got: %f", weight)
+	}
+
+	if height <= 0.0 {
+		return 0.0, fmt.Errorf("height must be greater than zero, got: %f", height)
+	}
+
+	if duration <= 0 {
+		return 0.0, fmt.Errorf("%w, got: %s", ErrNonPositiveDuration, duration)
+	}
 
+	calories, err := RunningCaloriesBySeconds(cyclingDistance(steps), weight, duration.Seconds())
 	if err != nil {
 		return 0.0, err
 	}
 
-	return calories * walkingCaloriesCoefficient, nil
+	return calories * cyclingCaloriesCoefficient, nil
+}
+
+// SwimmingSpentCalories рассчитывает количество потраченных калорий при плавании.
+// Дистанция считается как количество бассейнов, умноженное на длину бассейна,
+// а к результату применяется коэффициент swimmingCaloriesCoefficient.
+// Принимает:
+//   - laps: количество проплытых бассейнов (должно быть > 0)
+//   - poolLengthM: длина бассейна в метрах (должна быть > 0)
+//   - weight: вес пользователя в килограммах (должен быть > 0)
+//   - duration: продолжительность активности (должна быть > 0)
+//
+// Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных.
+func SwimmingSpentCalories(laps int, poolLengthM, weight float64, duration time.Duration) (float64, error) {
+	if laps <= 0 {
+		return 0.0, fmt.Errorf("laps must be greater than zero, got: %d", laps)
+	}
+
+	if poolLengthM <= 0.0 {
+		return 0.0, fmt.Errorf("pool length must be greater than zero, got: %f", poolLengthM)
+	}
+
+	if weight <= 0.0 {
+		return 0.0, fmt.Errorf("weight must be greater than zero, got: %f", weight)
+	}
+
+	if duration <= 0 {
+		return 0.0, fmt.Errorf("%w, got: %s", ErrNonPositiveDuration, duration)
+	}
+
+	calories, err := RunningCaloriesBySeconds(swimmingDistance(laps, poolLengthM), weight, duration.Seconds())
+	if err != nil {
+		return 0.0, err
+	}
+
+	return calories * swimmingCaloriesCoefficient, nil
+}
+
+// RoundCalories округляет количество калорий до ближайшего целого, половина округляется вверх.
+func RoundCalories(calories float64) int {
+	return int(math.Round(calories))
+}
+
+// RunningSpentCaloriesRounded работает как RunningSpentCalories, но округляет результат функцией RoundCalories.
+func RunningSpentCaloriesRounded(steps int, weight, height float64, duration time.Duration) (int, error) {
+	calories, err := RunningSpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return 0, err
+	}
+
+	return RoundCalories(calories), nil
+}
+
+// WalkingSpentCaloriesRounded работает как WalkingSpentCalories, но округляет результат функцией RoundCalories.
+func WalkingSpentCaloriesRounded(steps int, weight, height float64, duration time.Duration) (int, error) {
+	calories, err := WalkingSpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return 0, err
+	}
+
+	return RoundCalories(calories), nil
 }
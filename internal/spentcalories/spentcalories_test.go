@@ -1,6 +1,8 @@
 package spentcalories
 
 import (
+	"bytes"
+	"log"
 	"testing"
 	"time"
 
@@ -233,7 +235,7 @@ func (suite *SpentCaloriesTestSuite) TestDistance() {
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			got := distance(tt.steps, tt.height)
+			got := distance(tt.steps, tt.height, walking)
 			assert.Equal(suite.T(), tt.wantDist, got)
 		})
 	}
@@ -307,7 +309,7 @@ func (suite *SpentCaloriesTestSuite) TestMeanSpeed() {
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			got := meanSpeed(tt.steps, tt.height, tt.duration)
+			got := meanSpeed(tt.steps, tt.height, tt.duration, walking)
 			assert.Equal(suite.T(), tt.wantSpeed, got)
 		})
 	}
@@ -329,7 +331,7 @@ func (suite *SpentCaloriesTestSuite) TestRunningSpentCalories() {
 			weight:   75.0,
 			height:   1.75,
 			duration: 1 * time.Hour,
-			wantCal:  354.375,
+			wantCal:  511.875,
 			wantErr:  false,
 		},
 		{
@@ -338,7 +340,7 @@ func (suite *SpentCaloriesTestSuite) TestRunningSpentCalories() {
 			weight:   75.0,
 			height:   1.75,
 			duration: 30 * time.Minute,
-			wantCal:  177.1875,
+			wantCal:  255.9375,
 			wantErr:  false,
 		},
 		{
@@ -347,7 +349,7 @@ func (suite *SpentCaloriesTestSuite) TestRunningSpentCalories() {
 			weight:   75.0,
 			height:   1.75,
 			duration: 1 * time.Hour,
-			wantCal:  1181.25,
+			wantCal:  1706.25,
 			wantErr:  false,
 		},
 		{
@@ -356,7 +358,7 @@ func (suite *SpentCaloriesTestSuite) TestRunningSpentCalories() {
 			weight:   75.0,
 			height:   1.75,
 			duration: 2 * time.Hour,
-			wantCal:  59.0625,
+			wantCal:  85.3125,
 			wantErr:  false,
 		},
 		{
@@ -365,7 +367,7 @@ func (suite *SpentCaloriesTestSuite) TestRunningSpentCalories() {
 			weight:   60.0,
 			height:   1.75,
 			duration: 1 * time.Hour,
-			wantCal:  283.5,
+			wantCal:  409.5,
 			wantErr:  false,
 		},
 		{
@@ -581,7 +583,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "6000,Ходьба,1h00m",
 			weight:  75.0,
 			height:  1.75,
-			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 177.19\n",
+			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nТемп: 12.70 мин/км\nСожгли калорий: 177.19\nКаденс: 100.00 шаг/мин\n",
 			wantErr: false,
 		},
 		{
@@ -589,7 +591,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "6000,Бег,1h00m",
 			weight:  75.0,
 			height:  1.75,
-			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 354.38\n",
+			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 6.83 км.\nСкорость: 6.83 км/ч\nТемп: 8.79 мин/км\nСожгли калорий: 511.88\nКаденс: 100.00 шаг/мин\nВнимание: каденс ниже 160 шаг/мин\nЗона скорости: восстановительная\n",
 			wantErr: false,
 		},
 		{
@@ -597,7 +599,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "20000,Ходьба,1h00m",
 			weight:  75.0,
 			height:  1.75,
-			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 15.75 км.\nСкорость: 15.75 км/ч\nСожгли калорий: 590.62\n",
+			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 15.75 км.\nСкорость: 15.75 км/ч\nТемп: 3.81 мин/км\nСожгли калорий: 590.62\nКаденс: 333.33 шаг/мин\n",
 			wantErr: false,
 		},
 		{
@@ -605,7 +607,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "20000,Бег,1h00m",
 			weight:  75.0,
 			height:  1.75,
-			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 15.75 км.\nСкорость: 15.75 км/ч\nСожгли калорий: 1181.25\n",
+			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 22.75 км.\nСкорость: 22.75 км/ч\nТемп: 2.64 мин/км\nСожгли калорий: 1706.25\nКаденс: 333.33 шаг/мин\nЗона скорости: интервальная\n",
 			wantErr: false,
 		},
 		{
@@ -613,7 +615,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "6000,Ходьба,1h00m",
 			weight:  60.0,
 			height:  1.85,
-			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 5.00 км.\nСкорость: 5.00 км/ч\nСожгли калорий: 149.85\n",
+			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 5.00 км.\nСкорость: 5.00 км/ч\nТемп: 12.01 мин/км\nСожгли калорий: 149.85\nКаденс: 100.00 шаг/мин\n",
 			wantErr: false,
 		},
 		{
@@ -621,7 +623,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "6000,Бег,1h00m",
 			weight:  60.0,
 			height:  1.75,
-			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 283.50\n",
+			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 6.83 км.\nСкорость: 6.83 км/ч\nТемп: 8.79 мин/км\nСожгли калорий: 409.50\nКаденс: 100.00 шаг/мин\nВнимание: каденс ниже 160 шаг/мин\nЗона скорости: восстановительная\n",
 			wantErr: false,
 		},
 		{
@@ -629,7 +631,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "3000,Ходьба,30m",
 			weight:  75.0,
 			height:  1.75,
-			want:    "Тип тренировки: Ходьба\nДлительность: 0.50 ч.\nДистанция: 2.36 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 88.59\n",
+			want:    "Тип тренировки: Ходьба\nДлительность: 0.50 ч.\nДистанция: 2.36 км.\nСкорость: 4.72 км/ч\nТемп: 12.70 мин/км\nСожгли калорий: 88.59\nКаденс: 100.00 шаг/мин\n",
 			wantErr: false,
 		},
 		{
@@ -637,12 +639,12 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "3000,Бег,30m",
 			weight:  75.0,
 			height:  1.75,
-			want:    "Тип тренировки: Бег\nДлительность: 0.50 ч.\nДистанция: 2.36 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 177.19\n",
+			want:    "Тип тренировки: Бег\nДлительность: 0.50 ч.\nДистанция: 3.41 км.\nСкорость: 6.83 км/ч\nТемп: 8.79 мин/км\nСожгли калорий: 255.94\nКаденс: 100.00 шаг/мин\nВнимание: каденс ниже 160 шаг/мин\nЗона скорости: восстановительная\n",
 			wantErr: false,
 		},
 		{
 			name:    "неизвестный тип тренировки",
-			input:   "6000,Плавание,1h00m",
+			input:   "6000,Йога,1h00m",
 			weight:  75.0,
 			height:  1.75,
 			want:    "",
@@ -650,7 +652,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 		},
 		{
 			name:    "неизвестный тип тренировки - проверка текста ошибки",
-			input:   "6000,Плавание,1h00m",
+			input:   "6000,Йога,1h00m",
 			weight:  75.0,
 			height:  1.75,
 			want:    "",
@@ -700,3 +702,940 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 		})
 	}
 }
+
+func (suite *SpentCaloriesTestSuite) TestCyclingSpentCalories() {
+	tests := []struct {
+		name     string
+		steps    int
+		weight   float64
+		height   float64
+		duration time.Duration
+		wantCal  float64
+		wantErr  bool
+	}{
+		{
+			name:     "нормальная нагрузка - один час",
+			steps:    6000,
+			weight:   75.0,
+			height:   1.75,
+			duration: 1 * time.Hour,
+			wantCal:  787.5,
+			wantErr:  false,
+		},
+		{
+			name:     "нормальная нагрузка - полчаса",
+			steps:    3000,
+			weight:   75.0,
+			height:   1.75,
+			duration: 30 * time.Minute,
+			wantCal:  393.75,
+			wantErr:  false,
+		},
+		{
+			name:     "ноль оборотов",
+			steps:    0,
+			weight:   75.0,
+			height:   1.75,
+			duration: 1 * time.Hour,
+			wantCal:  0,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевой вес",
+			steps:    6000,
+			weight:   0,
+			height:   1.75,
+			duration: 1 * time.Hour,
+			wantCal:  0,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевой рост",
+			steps:    6000,
+			weight:   75.0,
+			height:   0,
+			duration: 1 * time.Hour,
+			wantCal:  0,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевая продолжительность",
+			steps:    6000,
+			weight:   75.0,
+			height:   1.75,
+			duration: 0,
+			wantCal:  0,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			gotCal, gotErr := CyclingSpentCalories(tt.steps, tt.weight, tt.height, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), gotErr)
+				assert.Equal(suite.T(), 0.0, gotCal)
+				return
+			}
+
+			assert.NoError(suite.T(), gotErr)
+			assert.InDelta(suite.T(), tt.wantCal, gotCal, 0.1)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestCyclingDiffersFromWalkingAndRunning() {
+	cyclingCal, err := CyclingSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+
+	runningCal, err := RunningSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+
+	walkingCal, err := WalkingSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+
+	assert.NotEqual(suite.T(), runningCal, cyclingCal)
+	assert.NotEqual(suite.T(), walkingCal, cyclingCal)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoCycling() {
+	got, err := TrainingInfo("6000,Велоспорт,1h00m", 75.0, 1.75)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Велоспорт\nДлительность: 1.00 ч.\n"+
+		"Дистанция: 30.00 км.\nСкорость: 30.00 км/ч\nТемп: 2.00 мин/км\nСожгли калорий: 787.50\n", got)
+}
+
+func (suite *SpentCaloriesTestSuite) TestLogger() {
+	defer func(logger *log.Logger) { Logger = logger }(Logger)
+
+	var buf bytes.Buffer
+	Logger = log.New(&buf, "", 0)
+
+	_, err := TrainingData("6000,Бег", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrInvalidFormat)
+	assert.Contains(suite.T(), buf.String(), ErrInvalidFormat.Error())
+
+	Logger = nil
+
+	_, err = TrainingData("6000,Бег", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrInvalidFormat)
+}
+
+func (suite *SpentCaloriesTestSuite) TestDelimiter() {
+	defer func(sep rune) { Delimiter = sep }(Delimiter)
+
+	want, err := TrainingInfo("6000,Бег,1h30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	tests := []struct {
+		name  string
+		sep   rune
+		input string
+	}{
+		{name: "табуляция", sep: '\t', input: "6000\tБег\t1h30m"},
+		{name: "вертикальная черта", sep: '|', input: "6000|Бег|1h30m"},
+		{name: "десятичная запятая", sep: '|', input: "6000|Бег|1,5h"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			Delimiter = tt.sep
+
+			got, err := TrainingInfo(tt.input, 75.0, 1.75)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), want, got)
+
+			_, err = TrainingInfo("6000,Бег,1h30m", 75.0, 1.75)
+			assert.ErrorIs(suite.T(), err, ErrInvalidFormat)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingData() {
+	tests := []struct {
+		name    string
+		input   string
+		weight  float64
+		height  float64
+		want    TrainingResult
+		wantErr bool
+	}{
+		{
+			name:   "ходьба - нормальная нагрузка",
+			input:  "6000,Ходьба,1h00m",
+			weight: 75.0,
+			height: 1.75,
+			want: TrainingResult{
+				Activity:   "Ходьба",
+				Duration:   time.Hour,
+				DistanceKm: 4.725,
+				SpeedKmh:   4.725,
+				Calories:   177.1875,
+				Cadence:    100,
+				Steps:      6000,
+			},
+			wantErr: false,
+		},
+		{
+			name:   "бег - полчаса",
+			input:  "3000,Бег,30m",
+			weight: 75.0,
+			height: 1.75,
+			want: TrainingResult{
+				Activity:   "Бег",
+				Duration:   30 * time.Minute,
+				DistanceKm: 3.4125,
+				SpeedKmh:   6.825,
+				Calories:   255.9375,
+				Cadence:    100,
+				Steps:      3000,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "неизвестный тип тренировки",
+			input:   "6000,Йога,1h00m",
+			weight:  75.0,
+			height:  1.75,
+			wantErr: true,
+		},
+		{
+			name:    "некорректный формат данных",
+			input:   "6000,Ходьба",
+			weight:  75.0,
+			height:  1.75,
+			wantErr: true,
+		},
+		{
+			name:    "нулевой вес",
+			input:   "6000,Ходьба,1h00m",
+			weight:  0,
+			height:  1.75,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingData(tt.input, tt.weight, tt.height)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), TrainingResult{}, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want.Activity, got.Activity)
+			assert.Equal(suite.T(), tt.want.Duration, got.Duration)
+			assert.InDelta(suite.T(), tt.want.DistanceKm, got.DistanceKm, 1e-9)
+			assert.InDelta(suite.T(), tt.want.SpeedKmh, got.SpeedKmh, 1e-9)
+			assert.InDelta(suite.T(), tt.want.Calories, got.Calories, 1e-9)
+			assert.InDelta(suite.T(), tt.want.Cadence, got.Cadence, 1e-9)
+			assert.Equal(suite.T(), tt.want.Steps, got.Steps)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingExported() {
+	steps, activity, duration, err := ParseTraining("5000,Бег,30m")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 5000, steps)
+	assert.Equal(suite.T(), "Бег", activity)
+	assert.Equal(suite.T(), 30*time.Minute, duration)
+
+	_, _, _, err = ParseTraining("5000,Бег")
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingErrors() {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+		wantMsg string
+	}{
+		{
+			name:    "неверный формат",
+			input:   "678,Ходьба",
+			wantErr: ErrInvalidFormat,
+			wantMsg: "invalid data format: 678,Ходьба",
+		},
+		{
+			name:    "шаги не число",
+			input:   "abc,Ходьба,1h",
+			wantErr: ErrInvalidSteps,
+			wantMsg: `parsing steps failed: strconv.Atoi: parsing "abc": invalid syntax`,
+		},
+		{
+			name:    "ноль шагов",
+			input:   "0,Ходьба,1h",
+			wantErr: ErrNonPositiveSteps,
+			wantMsg: "steps must be greater than zero, got: 0",
+		},
+		{
+			name:    "продолжительность не распознана",
+			input:   "678,Ходьба,invalid",
+			wantErr: ErrInvalidDuration,
+			wantMsg: `parsing duration failed: time: invalid duration "invalid"`,
+		},
+		{
+			name:    "нулевая продолжительность",
+			input:   "678,Ходьба,0h",
+			wantErr: ErrNonPositiveDuration,
+			wantMsg: "activity duration must be greater than zero, got: 0s",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, _, _, err := parseTraining(tt.input)
+
+			assert.ErrorIs(suite.T(), err, tt.wantErr)
+			assert.EqualError(suite.T(), err, tt.wantMsg)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestSwimmingSpentCalories() {
+	tests := []struct {
+		name        string
+		laps        int
+		poolLengthM float64
+		weight      float64
+		duration    time.Duration
+		wantCal     float64
+		wantErr     bool
+	}{
+		{
+			name:        "километр за полчаса",
+			laps:        40,
+			poolLengthM: 25,
+			weight:      75.0,
+			duration:    30 * time.Minute,
+			wantCal:     300,
+			wantErr:     false,
+		},
+		{
+			name:        "длинный бассейн",
+			laps:        20,
+			poolLengthM: 50,
+			weight:      60.0,
+			duration:    1 * time.Hour,
+			wantCal:     240,
+			wantErr:     false,
+		},
+		{
+			name:        "ноль бассейнов",
+			laps:        0,
+			poolLengthM: 25,
+			weight:      75.0,
+			duration:    30 * time.Minute,
+			wantErr:     true,
+		},
+		{
+			name:        "нулевая длина бассейна",
+			laps:        40,
+			poolLengthM: 0,
+			weight:      75.0,
+			duration:    30 * time.Minute,
+			wantErr:     true,
+		},
+		{
+			name:        "нулевой вес",
+			laps:        40,
+			poolLengthM: 25,
+			weight:      0,
+			duration:    30 * time.Minute,
+			wantErr:     true,
+		},
+		{
+			name:        "нулевая продолжительность",
+			laps:        40,
+			poolLengthM: 25,
+			weight:      75.0,
+			duration:    0,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			gotCal, gotErr := SwimmingSpentCalories(tt.laps, tt.poolLengthM, tt.weight, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), gotErr)
+				assert.Equal(suite.T(), 0.0, gotCal)
+				return
+			}
+
+			assert.NoError(suite.T(), gotErr)
+			assert.InDelta(suite.T(), tt.wantCal, gotCal, 0.1)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoSwimming() {
+	got, err := TrainingInfo("40,Плавание,30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Плавание\nДлительность: 0.50 ч.\n"+
+		"Дистанция: 1.00 км.\nСкорость: 2.00 км/ч\nТемп: 30.00 мин/км\nСожгли калорий: 300.00\n", got)
+
+	got, err = TrainingInfo("6000,Ходьба,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\n"+
+		"Дистанция: 4.72 км.\nСкорость: 4.72 км/ч\nТемп: 12.70 мин/км\nСожгли калорий: 177.19\n"+
+		"Каденс: 100.00 шаг/мин\n", got)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoSwimmingPoolLength() {
+	defer func(length float64) { PoolLengthM = length }(PoolLengthM)
+	PoolLengthM = 50
+
+	got, err := TrainingInfo("40,Плавание,30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Плавание\nДлительность: 0.50 ч.\n"+
+		"Дистанция: 2.00 км.\nСкорость: 4.00 км/ч\nТемп: 15.00 мин/км\nСожгли калорий: 600.00\n", got)
+}
+
+func (suite *SpentCaloriesTestSuite) TestValidateTraining() {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "корректная ходьба", input: "3456,Ходьба,3h00m", wantErr: false},
+		{name: "корректное плавание", input: "40,Плавание,30m", wantErr: false},
+		{name: "неверный формат", input: "678,Ходьба", wantErr: true},
+		{name: "ноль шагов", input: "0,Бег,1h", wantErr: true},
+		{name: "неверная продолжительность", input: "678,Бег,1.5d", wantErr: true},
+		{name: "неизвестный тип тренировки", input: "6000,Йога,1h00m", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := ValidateTraining(tt.input)
+
+			if !tt.wantErr {
+				assert.NoError(suite.T(), err)
+				return
+			}
+
+			_, wantErr := TrainingData(tt.input, 75.0, 1.75)
+			assert.EqualError(suite.T(), err, wantErr.Error())
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestDistanceAndMeanSpeedExported() {
+	tests := []struct {
+		name      string
+		steps     int
+		height    float64
+		duration  time.Duration
+		wantDist  float64
+		wantSpeed float64
+	}{
+		{
+			name:      "положительные значения",
+			steps:     6000,
+			height:    1.75,
+			duration:  1 * time.Hour,
+			wantDist:  4.725,
+			wantSpeed: 4.725,
+		},
+		{
+			name:      "ноль шагов",
+			steps:     0,
+			height:    1.75,
+			duration:  1 * time.Hour,
+			wantDist:  0,
+			wantSpeed: 0,
+		},
+		{
+			name:      "отрицательный рост",
+			steps:     6000,
+			height:    -1.75,
+			duration:  1 * time.Hour,
+			wantDist:  0,
+			wantSpeed: 0,
+		},
+		{
+			name:      "нулевая продолжительность",
+			steps:     6000,
+			height:    1.75,
+			duration:  0,
+			wantDist:  4.725,
+			wantSpeed: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.InDelta(suite.T(), tt.wantDist, Distance(tt.steps, tt.height), 1e-9)
+			assert.InDelta(suite.T(), tt.wantSpeed, MeanSpeed(tt.steps, tt.height, tt.duration), 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingActivityNormalization() {
+	tests := []struct {
+		name         string
+		input        string
+		wantActivity string
+	}{
+		{name: "бег - пробелы по краям", input: "1000, Бег ,30m", wantActivity: "Бег"},
+		{name: "бег - нижний регистр", input: "1000,бег,30m", wantActivity: "Бег"},
+		{name: "бег - верхний регистр с пробелами", input: "1000,  БЕГ,30m", wantActivity: "Бег"},
+		{name: "ходьба - пробелы по краям", input: "1000, Ходьба ,30m", wantActivity: "Ходьба"},
+		{name: "ходьба - смешанный регистр", input: "1000,хОдЬбА,30m", wantActivity: "Ходьба"},
+		{name: "велоспорт - нижний регистр с пробелом", input: "1000,велоспорт ,30m", wantActivity: "Велоспорт"},
+		{name: "плавание - верхний регистр", input: "40,ПЛАВАНИЕ,30m", wantActivity: "Плавание"},
+		{name: "неизвестная активность - только обрезка", input: "1000, Йога ,30m", wantActivity: "Йога"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, activity, _, err := parseTraining(tt.input)
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.wantActivity, activity)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoActivityNormalization() {
+	got, err := TrainingInfo("6000, бег ,1h00m", 75.0, 1.75)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 6.83 км.\n"+
+		"Скорость: 6.83 км/ч\nТемп: 8.79 мин/км\nСожгли калорий: 511.88\n"+
+		"Каденс: 100.00 шаг/мин\nВнимание: каденс ниже 160 шаг/мин\nЗона скорости: восстановительная\n", got)
+}
+
+func (suite *SpentCaloriesTestSuite) TestStepsForDistance() {
+	tests := []struct {
+		name       string
+		distanceKm float64
+		height     float64
+		wantSteps  int
+		wantErr    bool
+	}{
+		{name: "точное количество шагов", distanceKm: 4.725, height: 1.75, wantSteps: 6000},
+		{name: "округление вверх", distanceKm: 5, height: 1.75, wantSteps: 6350},
+		{name: "короткая дистанция", distanceKm: 0.0001, height: 1.75, wantSteps: 1},
+		{name: "нулевая дистанция", distanceKm: 0, height: 1.75, wantErr: true},
+		{name: "отрицательная дистанция", distanceKm: -5, height: 1.75, wantErr: true},
+		{name: "нулевой рост", distanceKm: 5, height: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := StepsForDistance(tt.distanceKm, tt.height)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.wantSteps, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestStepsForDistanceRoundTrip() {
+	for _, km := range []float64{0.5, 1, 3.2, 5, 10, 42.195} {
+		steps, err := StepsForDistance(km, 1.87)
+
+		assert.NoError(suite.T(), err)
+		assert.InDelta(suite.T(), km, distance(steps, 1.87, ""), 0.001)
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingSep() {
+	tests := []struct {
+		name         string
+		input        string
+		sep          rune
+		wantSteps    int
+		wantDuration time.Duration
+		wantErr      bool
+	}{
+		{
+			name:         "запятая по умолчанию",
+			input:        "5000,Бег,1.5h",
+			sep:          ',',
+			wantSteps:    5000,
+			wantDuration: 90 * time.Minute,
+		},
+		{
+			name:         "точка с запятой и десятичная запятая",
+			input:        "5000;Бег;1,5h",
+			sep:          ';',
+			wantSteps:    5000,
+			wantDuration: 90 * time.Minute,
+		},
+		{
+			name:         "точка с запятой и десятичная точка",
+			input:        "5000;Ходьба;0.5h",
+			sep:          ';',
+			wantSteps:    5000,
+			wantDuration: 30 * time.Minute,
+		},
+		{
+			name:    "десятичная запятая при разделителе-запятой",
+			input:   "5000,Бег,1,5h",
+			sep:     ',',
+			wantErr: true,
+		},
+		{
+			name:    "строка с другим разделителем",
+			input:   "5000,Бег,30m",
+			sep:     ';',
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			steps, _, duration, err := ParseTrainingSep(tt.input, tt.sep)
+
+			if tt.wantErr {
+				assert.ErrorIs(suite.T(), err, ErrInvalidFormat)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.wantSteps, steps)
+			assert.Equal(suite.T(), tt.wantDuration, duration)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestRunningCaloriesByDistance() {
+	tests := []struct {
+		name       string
+		distanceKm float64
+		weight     float64
+		duration   time.Duration
+		wantCal    float64
+		wantErr    bool
+	}{
+		{name: "десять километров за час", distanceKm: 10, weight: 75.0, duration: time.Hour, wantCal: 750},
+		{name: "пять километров за полчаса", distanceKm: 5, weight: 60.0, duration: 30 * time.Minute, wantCal: 300},
+		{name: "короткая тренировка", distanceKm: 0.005, weight: 75.0, duration: time.Second, wantCal: 0.375},
+		{name: "нулевая дистанция", distanceKm: 0, weight: 75.0, duration: time.Hour, wantErr: true},
+		{name: "нулевой вес", distanceKm: 10, weight: 0, duration: time.Hour, wantErr: true},
+		{name: "нулевая продолжительность", distanceKm: 10, weight: 75.0, duration: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := RunningCaloriesByDistance(tt.distanceKm, tt.weight, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantCal, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestRunningCaloriesBySeconds() {
+	tests := []struct {
+		name       string
+		distanceKm float64
+		weight     float64
+		seconds    float64
+		want       float64
+		wantErr    bool
+	}{
+		{name: "час", distanceKm: 10, weight: 75.0, seconds: 3600, want: 750},
+		{name: "полчаса", distanceKm: 5, weight: 60.0, seconds: 1800, want: 300},
+		{name: "одна секунда", distanceKm: 0.005, weight: 75.0, seconds: 1, want: 0.375},
+		{name: "доля секунды", distanceKm: 0.0001, weight: 72.0, seconds: 0.02, want: 0.0072},
+		{name: "нулевая дистанция", distanceKm: 0, weight: 75.0, seconds: 3600, wantErr: true},
+		{name: "нулевой вес", distanceKm: 10, weight: 0, seconds: 3600, wantErr: true},
+		{name: "нулевая продолжительность", distanceKm: 10, weight: 75.0, seconds: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := RunningCaloriesBySeconds(tt.distanceKm, tt.weight, tt.seconds)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-12)
+		})
+	}
+
+	_, err := RunningCaloriesBySeconds(10, 75.0, 0)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDuration)
+}
+
+func (suite *SpentCaloriesTestSuite) TestRunningCaloriesByDistanceMatchesSeconds() {
+	byDuration, err := RunningCaloriesByDistance(0.005, 75.0, time.Second)
+	assert.NoError(suite.T(), err)
+
+	bySeconds, err := RunningCaloriesBySeconds(0.005, 75.0, 1)
+	assert.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), bySeconds, byDuration)
+}
+
+func (suite *SpentCaloriesTestSuite) TestRunningCaloriesByDistanceMatchesSteps() {
+	bySteps, err := RunningSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+
+	byDistance, err := RunningCaloriesByDistance(distance(6000, 1.75, running), 75.0, time.Hour)
+	assert.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), bySteps, byDistance)
+}
+
+func (suite *SpentCaloriesTestSuite) TestCheckStepRate() {
+	tests := []struct {
+		name     string
+		steps    int
+		duration time.Duration
+		maxRate  float64
+		wantErr  bool
+	}{
+		{name: "обычный темп", steps: 6000, duration: time.Hour, maxRate: 400},
+		{name: "на границе", steps: 12000, duration: 30 * time.Minute, maxRate: 400},
+		{name: "сбой шагомера", steps: 9000000, duration: 30 * time.Minute, maxRate: 400, wantErr: true},
+		{name: "пониженный порог", steps: 6000, duration: 30 * time.Minute, maxRate: 150, wantErr: true},
+		{name: "нулевая продолжительность не проверяется", steps: 6000, duration: 0, maxRate: 400},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			defer func(rate float64) { MaxStepRate = rate }(MaxStepRate)
+			MaxStepRate = tt.maxRate
+
+			err := CheckStepRate(tt.steps, tt.duration)
+
+			if tt.wantErr {
+				assert.ErrorIs(suite.T(), err, ErrImplausibleStepRate)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoImplausibleStepRate() {
+	got, err := TrainingInfo("9000000,Ходьба,30m", 75.0, 1.75)
+
+	assert.ErrorIs(suite.T(), err, ErrImplausibleStepRate)
+	assert.Empty(suite.T(), got)
+}
+
+func (suite *SpentCaloriesTestSuite) TestCheckLimits() {
+	tests := []struct {
+		name     string
+		steps    int
+		duration time.Duration
+		wantErr  error
+	}{
+		{name: "в пределах ограничений", steps: 6000, duration: time.Hour},
+		{name: "на границе", steps: 500_000, duration: 24 * time.Hour},
+		{name: "слишком много шагов", steps: 500_001, duration: time.Hour, wantErr: ErrTooManySteps},
+		{name: "слишком долго", steps: 6000, duration: 24*time.Hour + time.Minute, wantErr: ErrDurationTooLong},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := CheckLimits(tt.steps, tt.duration)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(suite.T(), err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingLimits() {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{name: "слишком много шагов", input: "500001,Ходьба,24h", wantErr: ErrTooManySteps},
+		{name: "слишком долго", input: "6000,Ходьба,25h", wantErr: ErrDurationTooLong},
+		{name: "слишком долго по часам", input: "6000,Ходьба,100:00", wantErr: ErrDurationTooLong},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, _, _, err := parseTraining(tt.input)
+
+			assert.ErrorIs(suite.T(), err, tt.wantErr)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingConfigurableLimits() {
+	defer func(steps int, duration time.Duration) {
+		MaxSteps, MaxDuration = steps, duration
+	}(MaxSteps, MaxDuration)
+
+	MaxSteps, MaxDuration = 5000, 48*time.Hour
+
+	_, _, _, err := parseTraining("6000,Ходьба,1h")
+	assert.ErrorIs(suite.T(), err, ErrTooManySteps)
+
+	_, _, _, err = parseTraining("4000,Ходьба,30h")
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestRoundCalories() {
+	tests := []struct {
+		name     string
+		calories float64
+		want     int
+	}{
+		{name: "половина округляется вверх", calories: 0.5, want: 1},
+		{name: "меньше половины", calories: 177.49, want: 177},
+		{name: "ровно половина", calories: 177.5, want: 178},
+		{name: "больше половины", calories: 354.375, want: 354},
+		{name: "ноль", calories: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, RoundCalories(tt.calories))
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestSpentCaloriesRounded() {
+	running, err := RunningSpentCaloriesRounded(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 512, running)
+
+	walking, err := WalkingSpentCaloriesRounded(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 177, walking)
+
+	_, err = RunningSpentCaloriesRounded(0, 75.0, 1.75, time.Hour)
+	assert.Error(suite.T(), err)
+
+	_, err = WalkingSpentCaloriesRounded(6000, 0, 1.75, time.Hour)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingActivityShape() {
+	tests := []struct {
+		name      string
+		input     string
+		wantCount int
+		wantErr   error
+		wantMsg   string
+	}{
+		{
+			name:      "бег - шаги",
+			input:     "5000,Бег,30m",
+			wantCount: 5000,
+		},
+		{
+			name:      "ходьба - шаги",
+			input:     "3000,Ходьба,30m",
+			wantCount: 3000,
+		},
+		{
+			name:      "велоспорт - обороты педалей",
+			input:     "3000,Велоспорт,30m",
+			wantCount: 3000,
+		},
+		{
+			name:      "плавание - бассейны",
+			input:     "40,Плавание,30m",
+			wantCount: 40,
+		},
+		{
+			name:    "бег - неправдоподобный темп шагов",
+			input:   "15000,Бег,30m",
+			wantErr: ErrImplausibleStepRate,
+			wantMsg: "implausible step rate: 500.00 steps/min exceeds 400.00",
+		},
+		{
+			name:    "велоспорт - неправдоподобные обороты",
+			input:   "12000,Велоспорт,30m",
+			wantErr: ErrActivityMismatch,
+			wantMsg: "data does not match activity: 400.00 revolutions/min for Велоспорт exceeds 200.00",
+		},
+		{
+			name:    "плавание - шаги вместо бассейнов",
+			input:   "5000,Плавание,30m",
+			wantErr: ErrActivityMismatch,
+			wantMsg: "data does not match activity: 166.67 laps/min for Плавание exceeds 6.00",
+		},
+		{
+			name:    "плавание - дробное количество бассейнов",
+			input:   "12.5,Плавание,30m",
+			wantErr: ErrInvalidSteps,
+			wantMsg: `parsing steps failed: laps for Плавание must be an integer: value "12.5" is not a whole number`,
+		},
+		{
+			name:    "плавание - неверное количество полей",
+			input:   "40,Плавание",
+			wantErr: ErrInvalidFormat,
+			wantMsg: "invalid data format: Плавание expects 'laps,activity,duration', got: 40,Плавание",
+		},
+		{
+			name:    "велоспорт - лишнее поле",
+			input:   "3000,Велоспорт,30m,10",
+			wantErr: ErrInvalidFormat,
+			wantMsg: "invalid data format: Велоспорт expects 'revolutions,activity,duration', got: 3000,Велоспорт,30m,10",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			count, _, _, err := parseTraining(tt.input)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(suite.T(), err, tt.wantErr)
+				assert.EqualError(suite.T(), err, tt.wantMsg)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.wantCount, count)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestUnknownActivityError() {
+	wantMsg := `неизвестный тип тренировки: "Йога", supported: Бег, Ходьба, Велоспорт, Плавание, Подъём по лестнице`
+
+	_, err := TrainingData("6000,Йога,1h00m", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+	assert.EqualError(suite.T(), err, wantMsg)
+
+	err = ValidateTraining("6000,Йога,1h00m")
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+	assert.EqualError(suite.T(), err, wantMsg)
+
+	_, err = CaloriesAtElapsed(6000, 75.0, 1.75, time.Hour, 30*time.Minute, "Йога")
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+}
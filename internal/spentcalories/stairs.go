@@ -0,0 +1,61 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// Константы, используемые для расчёта калорий при подъёме по лестнице.
+const (
+	gravityMS2             = 9.81 // ускорение свободного падения, м/с².
+	stairsFlightHeightM    = 3.0  // высота одного пролёта (этажа) в метрах.
+	stairsMuscleEfficiency = 0.2  // КПД мышц при подъёме по лестнице.
+
+	// stairsKcalPerKgPerFlight - энергия в килокалориях на подъём одного килограмма веса на один пролёт:
+	// работа против силы тяжести g * высота пролёта, делённая на КПД мышц, примерно 0.035 ккал/кг.
+	stairsKcalPerKgPerFlight = gravityMS2 * stairsFlightHeightM / stairsMuscleEfficiency / (kJInKcal * 1000)
+)
+
+// stairsDistance рассчитывает горизонтальную дистанцию подъёма по лестнице в километрах.
+// Рост пользователя не учитывается: длина шага по ступеням принимается равной LenStep.
+func stairsDistance(steps int) float64 {
+	if steps <= 0 {
+		return 0.0
+	}
+
+	return float64(steps) * LenStep / float64(MInKm)
+}
+
+// StairsSpentCalories рассчитывает количество потраченных калорий при подъёме по лестнице.
+// Калории складываются из горизонтальной части, рассчитанной как для ходьбы с коэффициентом
+// walkingCaloriesCoefficient по дистанции stairsDistance, и вертикальной работы -
+// stairsKcalPerKgPerFlight на килограмм веса за каждый пролёт.
+// Принимает:
+//   - steps: количество шагов (должно быть > 0)
+//   - weight: вес пользователя в килограммах (должен быть > 0)
+//   - flights: количество пролётов (должно быть >= 0)
+//   - d: продолжительность активности (должна быть > 0)
+//
+// Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных.
+func StairsSpentCalories(steps int, weight float64, flights int, d time.Duration) (float64, error) {
+	if steps <= 0 {
+		return 0.0, fmt.Errorf("%w, got: %d", ErrNonPositiveSteps, steps)
+	}
+
+	if weight <= 0.0 {
+		return 0.0, fmt.Errorf("weight must be greater than zero, got: %f", weight)
+	}
+
+	if flights < 0 {
+		return 0.0, fmt.Errorf("flights must not be negative, got: %d", flights)
+	}
+
+	if d <= 0 {
+		return 0.0, fmt.Errorf("%w, got: %s", ErrNonPositiveDuration, d)
+	}
+
+	horizontal := walkingCaloriesCoefficient * weight * stairsDistance(steps)
+	vertical := float64(flights) * weight * stairsKcalPerKgPerFlight
+
+	return horizontal + vertical, nil
+}
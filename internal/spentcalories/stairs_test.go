@@ -0,0 +1,131 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestStairsSpentCalories() {
+	tests := []struct {
+		name    string
+		steps   int
+		weight  float64
+		flights int
+		d       time.Duration
+		want    float64
+		wantErr bool
+		errIs   error
+	}{
+		{name: "с пролётами", steps: 2000, weight: 75, flights: 20, d: 20 * time.Minute, want: 101.504541},
+		{name: "без пролётов", steps: 2000, weight: 75, flights: 0, d: 20 * time.Minute, want: 48.75},
+		{name: "нулевые шаги", steps: 0, weight: 75, flights: 20, d: 20 * time.Minute, wantErr: true, errIs: ErrNonPositiveSteps},
+		{name: "нулевой вес", steps: 2000, weight: 0, flights: 20, d: 20 * time.Minute, wantErr: true},
+		{name: "отрицательные пролёты", steps: 2000, weight: 75, flights: -1, d: 20 * time.Minute, wantErr: true},
+		{
+			name: "нулевая продолжительность", steps: 2000, weight: 75, flights: 20, d: 0,
+			wantErr: true, errIs: ErrNonPositiveDuration,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := StairsSpentCalories(tt.steps, tt.weight, tt.flights, tt.d)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				if tt.errIs != nil {
+					assert.ErrorIs(suite.T(), err, tt.errIs)
+				}
+				assert.Zero(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-6)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoStairs() {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "подъём по лестнице",
+			input: "2000,Подъём по лестнице,20m,20",
+			want: "Тип тренировки: Подъём по лестнице\nДлительность: 0.33 ч.\nДистанция: 1.30 км.\nСкорость: 3.90 км/ч\n" +
+				"Темп: 15.38 мин/км\nСожгли калорий: 101.50\n",
+		},
+		{
+			name:    "нет количества пролётов",
+			input:   "2000,Подъём по лестнице,20m",
+			wantErr: ErrInvalidFormat,
+		},
+		{
+			name:    "пролёты не число",
+			input:   "2000,Подъём по лестнице,20m,много",
+			wantErr: ErrInvalidFormat,
+		},
+		{
+			name:    "отрицательные пролёты",
+			input:   "2000,Подъём по лестнице,20m,-3",
+			wantErr: ErrInvalidFormat,
+		},
+		{
+			name:    "четвёртое поле для другой активности",
+			input:   "2000,Ходьба,20m,20",
+			wantErr: ErrInvalidFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfo(tt.input, 75.0, 1.75)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(suite.T(), err, tt.wantErr)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingStairs() {
+	steps, activity, duration, flights, err := ParseTrainingFlights("2000,подъём по лестнице,20m,20")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2000, steps)
+	assert.Equal(suite.T(), "Подъём по лестнице", activity)
+	assert.Equal(suite.T(), 20*time.Minute, duration)
+	assert.Equal(suite.T(), 20, flights)
+
+	_, _, _, flights, err = ParseTrainingFlights("6000,Бег,1h00m")
+	assert.NoError(suite.T(), err)
+	assert.Zero(suite.T(), flights)
+
+	assert.NoError(suite.T(), ValidateTraining("2000,Подъём по лестнице,20m,20"))
+
+	_, _, _, err = ParseTraining("2000,Подъём по лестнице,20m,20")
+	assert.ErrorIs(suite.T(), err, ErrFlightsRequired)
+
+	_, _, _, err = ParseTrainingSep("2000;Подъём по лестнице;20m;20", ';')
+	assert.ErrorIs(suite.T(), err, ErrFlightsRequired)
+
+	_, _, _, _, err = ParseTrainingWithTime("2024-01-02T10:00,2000,Подъём по лестнице,20m,20")
+	assert.ErrorIs(suite.T(), err, ErrFlightsRequired)
+}
+
+func (suite *SpentCaloriesTestSuite) TestStairsWithoutFlights() {
+	_, err := CaloriesPerKm(2000, 75.0, 1.75, 20*time.Minute, "Подъём по лестнице")
+	assert.ErrorIs(suite.T(), err, ErrFlightsRequired)
+
+	_, err = CaloriesAtElapsed(2000, 75.0, 1.75, 20*time.Minute, 10*time.Minute, "Подъём по лестнице")
+	assert.ErrorIs(suite.T(), err, ErrFlightsRequired)
+}
@@ -0,0 +1,226 @@
+package spentcalories
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WeeklySummary формирует сводный отчёт по нескольким тренировкам.
+// Принимает:
+//   - entries: строки с данными о тренировках в формате "количество_шагов,тип_активности,продолжительность"
+//   - weight: вес пользователя в килограммах
+//   - height: рост пользователя в метрах
+//
+// Возвращает отчёт с общей длительностью, дистанцией, калориями и количеством тренировок
+// каждого встроенного типа. Если какие-то строки невалидны, возвращает ошибку с номерами всех таких строк.
+func WeeklySummary(entries []string, weight, height float64) (string, error) {
+	var (
+		totalDuration       time.Duration
+		totalDist, totalCal float64
+		counts              = make(map[string]int)
+		errs                []error
+	)
+
+	for i, entry := range entries {
+		result, err := TrainingData(entry, weight, height)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("entry %d: %w", i, err))
+			continue
+		}
+
+		totalDuration += result.Duration
+		totalDist += result.DistanceKm
+		totalCal += result.Calories
+		counts[result.Activity]++
+	}
+
+	if len(errs) > 0 {
+		return "", errors.Join(errs...)
+	}
+
+	breakdown := make([]string, 0, len(activities))
+	for _, activity := range activities {
+		breakdown = append(breakdown, fmt.Sprintf("%s: %d", strings.ToLower(activity), counts[activity]))
+	}
+
+	return fmt.Sprintf("Количество тренировок: %d (%s)\n"+
+		"Общая длительность: %.2f ч.\nОбщая дистанция: %.2f км.\nВсего сожгли калорий: %.2f\n",
+		len(entries), strings.Join(breakdown, ", "),
+		totalDuration.Hours(), totalDist, totalCal), nil
+}
+
+// Totals содержит суммарные показатели нескольких тренировок.
+type Totals struct {
+	TotalDistanceKm float64            // общая дистанция в километрах.
+	TotalCalories   float64            // общее количество потраченных калорий.
+	TotalDuration   time.Duration      // общая продолжительность тренировок.
+	SessionCount    int                // количество тренировок.
+	PerActivity     map[string]float64 // количество калорий по типам активности.
+}
+
+// AggregateSessions рассчитывает суммарные показатели нескольких тренировок.
+// Принимает те же параметры, что и WeeklySummary, и возвращает те же итоги в виде структуры.
+// Если какие-то строки невалидны, возвращает пустой Totals и ошибку с номерами всех таких строк.
+func AggregateSessions(data []string, weight, height float64) (Totals, error) {
+	totals := Totals{PerActivity: make(map[string]float64)}
+
+	var errs []error
+	for i, entry := range data {
+		result, err := TrainingData(entry, weight, height)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("entry %d: %w", i, err))
+			continue
+		}
+
+		totals.TotalDistanceKm += result.DistanceKm
+		totals.TotalCalories += result.Calories
+		totals.TotalDuration += result.Duration
+		totals.SessionCount++
+		totals.PerActivity[result.Activity] += result.Calories
+	}
+
+	if len(errs) > 0 {
+		return Totals{}, errors.Join(errs...)
+	}
+
+	return totals, nil
+}
+
+// IntervalTrainingInfo формирует отчёт об интервальной тренировке.
+// Каждый отрезок задаётся строкой в формате "количество_шагов,тип_активности,продолжительность",
+// поэтому в одной тренировке можно чередовать, например, бег и ходьбу.
+// Отчёт содержит длительность, дистанцию и калории каждого отрезка и итог по тренировке.
+// Если какие-то отрезки невалидны, возвращает ошибку с номерами всех таких отрезков.
+func IntervalTrainingInfo(segments []string, weight, height float64) (string, error) {
+	if len(segments) == 0 {
+		return "", fmt.Errorf("interval training must have at least one segment")
+	}
+
+	var (
+		report              strings.Builder
+		totalDuration       time.Duration
+		totalDist, totalCal float64
+		errs                []error
+	)
+
+	for i, segment := range segments {
+		result, err := TrainingData(segment, weight, height)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("segment %d: %w", i, err))
+			continue
+		}
+
+		fmt.Fprintf(&report, "Отрезок %d: %s, %.2f ч., %.2f км, %.2f ккал.\n",
+			i+1, result.Activity, result.Duration.Hours(), result.DistanceKm, result.Calories)
+
+		totalDuration += result.Duration
+		totalDist += result.DistanceKm
+		totalCal += result.Calories
+	}
+
+	if len(errs) > 0 {
+		return "", errors.Join(errs...)
+	}
+
+	fmt.Fprintf(&report, "Всего: %.2f ч., %.2f км, %.2f ккал.\n", totalDuration.Hours(), totalDist, totalCal)
+
+	return report.String(), nil
+}
+
+// multiSeparator разделяет тренировки в одной строке, которую принимает TrainingInfoMulti.
+const multiSeparator = ";"
+
+// TrainingInfoMulti формирует отчёт о круговой тренировке, записанной одной строкой,
+// например "5000,Бег,30m;2000,Ходьба,20m". Тренировки разделяются multiSeparator,
+// каждая из них разбирается так же, как в TrainingData, поэтому Delimiter не должен быть точкой с запятой.
+// Отчёт содержит длительность, дистанцию и калории по каждому типу активности в порядке
+// первого появления и итог по всем тренировкам.
+// Если какие-то тренировки невалидны, возвращает ошибку с номерами всех таких тренировок.
+func TrainingInfoMulti(data string, weight, height float64) (string, error) {
+	type activityTotals struct {
+		duration time.Duration
+		dist     float64
+		cal      float64
+	}
+
+	var (
+		order  []string
+		totals = make(map[string]*activityTotals)
+		total  activityTotals
+		errs   []error
+	)
+
+	for i, entry := range strings.Split(data, multiSeparator) {
+		result, err := TrainingData(entry, weight, height)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("sub-entry %d: %w", i, err))
+			continue
+		}
+
+		t, ok := totals[result.Activity]
+		if !ok {
+			t = &activityTotals{}
+			totals[result.Activity] = t
+			order = append(order, result.Activity)
+		}
+
+		t.duration += result.Duration
+		t.dist += result.DistanceKm
+		t.cal += result.Calories
+
+		total.duration += result.Duration
+		total.dist += result.DistanceKm
+		total.cal += result.Calories
+	}
+
+	if len(errs) > 0 {
+		return "", errors.Join(errs...)
+	}
+
+	var report strings.Builder
+	for _, activity := range order {
+		t := totals[activity]
+		fmt.Fprintf(&report, "%s: %.2f ч., %.2f км, %.2f ккал.\n", activity, t.duration.Hours(), t.dist, t.cal)
+	}
+
+	fmt.Fprintf(&report, "Всего: %.2f ч., %.2f км, %.2f ккал.\n", total.duration.Hours(), total.dist, total.cal)
+
+	return report.String(), nil
+}
+
+// TopCalorieActivity находит тренировку, на которой было потрачено больше всего калорий.
+// Принимает те же параметры, что и WeeklySummary.
+// Возвращает тип активности этой тренировки и количество калорий; при равенстве калорий
+// выбирается первая тренировка. Если какие-то строки невалидны, возвращает ошибку
+// с номерами всех таких строк. Для пустого entries возвращает ошибку.
+func TopCalorieActivity(entries []string, weight, height float64) (string, float64, error) {
+	if len(entries) == 0 {
+		return "", 0, errors.New("no trainings")
+	}
+
+	var (
+		topActivity string
+		topCal      float64
+		errs        []error
+	)
+
+	for i, entry := range entries {
+		result, err := TrainingData(entry, weight, height)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("entry %d: %w", i, err))
+			continue
+		}
+
+		if topActivity == "" || result.Calories > topCal {
+			topActivity, topCal = result.Activity, result.Calories
+		}
+	}
+
+	if len(errs) > 0 {
+		return "", 0, errors.Join(errs...)
+	}
+
+	return topActivity, topCal, nil
+}
@@ -0,0 +1,234 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestWeeklySummary() {
+	tests := []struct {
+		name       string
+		entries    []string
+		want       string
+		wantErrIdx []string
+	}{
+		{
+			name:    "бег и ходьба",
+			entries: []string{"6000,Ходьба,1h00m", "3000,Бег,30m", "3000,Ходьба,30m"},
+			want: "Количество тренировок: 3 (бег: 1, ходьба: 2, велоспорт: 0, плавание: 0, подъём по лестнице: 0)\n" +
+				"Общая длительность: 2.00 ч.\nОбщая дистанция: 10.50 км.\nВсего сожгли калорий: 521.72\n",
+		},
+		{
+			name:    "подъём по лестнице",
+			entries: []string{"3000,Бег,30m", "2000,Подъём по лестнице,20m,15"},
+			want: "Количество тренировок: 2 (бег: 1, ходьба: 0, велоспорт: 0, плавание: 0, подъём по лестнице: 1)\n" +
+				"Общая длительность: 0.83 ч.\nОбщая дистанция: 4.71 км.\nВсего сожгли калорий: 344.25\n",
+		},
+		{
+			name:    "пустой список",
+			entries: nil,
+			want: "Количество тренировок: 0 (бег: 0, ходьба: 0, велоспорт: 0, плавание: 0, подъём по лестнице: 0)\n" +
+				"Общая длительность: 0.00 ч.\nОбщая дистанция: 0.00 км.\nВсего сожгли калорий: 0.00\n",
+		},
+		{
+			name:       "невалидные строки",
+			entries:    []string{"6000,Ходьба,1h00m", "bad", "3000,Бег,30m", "0,Бег,30m"},
+			wantErrIdx: []string{"entry 1", "entry 3"},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := WeeklySummary(tt.entries, 75.0, 1.75)
+
+			if len(tt.wantErrIdx) > 0 {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				for _, idx := range tt.wantErrIdx {
+					assert.Contains(suite.T(), err.Error(), idx)
+				}
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestAggregateSessions() {
+	got, err := AggregateSessions([]string{"3000,Бег,15m", "1000,Ходьба,10m", "3000,Бег,15m"}, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, got.SessionCount)
+	assert.Equal(suite.T(), 40*time.Minute, got.TotalDuration)
+	assert.InDelta(suite.T(), 7.6125, got.TotalDistanceKm, 1e-9)
+	assert.InDelta(suite.T(), 541.40625, got.TotalCalories, 1e-9)
+	assert.Len(suite.T(), got.PerActivity, 2)
+	assert.InDelta(suite.T(), 511.875, got.PerActivity["Бег"], 1e-9)
+	assert.InDelta(suite.T(), 29.53125, got.PerActivity["Ходьба"], 1e-9)
+}
+
+func (suite *SpentCaloriesTestSuite) TestAggregateSessionsEmpty() {
+	got, err := AggregateSessions(nil, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Zero(suite.T(), got.SessionCount)
+	assert.Zero(suite.T(), got.TotalCalories)
+	assert.NotNil(suite.T(), got.PerActivity)
+	assert.Empty(suite.T(), got.PerActivity)
+}
+
+func (suite *SpentCaloriesTestSuite) TestAggregateSessionsErrors() {
+	got, err := AggregateSessions([]string{"3000,Бег,15m", "bad", "1000,Йога,10m"}, 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrInvalidFormat)
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+	assert.ErrorContains(suite.T(), err, "entry 1:")
+	assert.ErrorContains(suite.T(), err, "entry 2:")
+	assert.Equal(suite.T(), Totals{}, got)
+}
+
+func (suite *SpentCaloriesTestSuite) TestIntervalTrainingInfo() {
+	tests := []struct {
+		name       string
+		segments   []string
+		want       string
+		wantErrIdx []string
+	}{
+		{
+			name:     "бег и ходьба",
+			segments: []string{"3000,Бег,15m", "1000,Ходьба,10m", "3000,Бег,15m"},
+			want: "Отрезок 1: Бег, 0.25 ч., 3.41 км, 255.94 ккал.\n" +
+				"Отрезок 2: Ходьба, 0.17 ч., 0.79 км, 29.53 ккал.\n" +
+				"Отрезок 3: Бег, 0.25 ч., 3.41 км, 255.94 ккал.\n" +
+				"Всего: 0.67 ч., 7.61 км, 541.41 ккал.\n",
+		},
+		{
+			name:       "нет отрезков",
+			segments:   nil,
+			wantErrIdx: []string{"at least one segment"},
+		},
+		{
+			name:       "невалидные отрезки",
+			segments:   []string{"3000,Бег,15m", "bad", "1000,Йога,10m"},
+			wantErrIdx: []string{"segment 1", "segment 2"},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := IntervalTrainingInfo(tt.segments, 75.0, 1.75)
+
+			if len(tt.wantErrIdx) > 0 {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				for _, idx := range tt.wantErrIdx {
+					assert.Contains(suite.T(), err.Error(), idx)
+				}
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoMulti() {
+	tests := []struct {
+		name       string
+		data       string
+		want       string
+		wantErrIdx []string
+	}{
+		{
+			name: "бег и ходьба",
+			data: "3000,Бег,15m;1000,Ходьба,10m;3000,Бег,15m",
+			want: "Бег: 0.50 ч., 6.83 км, 511.88 ккал.\n" +
+				"Ходьба: 0.17 ч., 0.79 км, 29.53 ккал.\n" +
+				"Всего: 0.67 ч., 7.61 км, 541.41 ккал.\n",
+		},
+		{
+			name: "одна тренировка",
+			data: "6000,Ходьба,1h",
+			want: "Ходьба: 1.00 ч., 4.72 км, 177.19 ккал.\nВсего: 1.00 ч., 4.72 км, 177.19 ккал.\n",
+		},
+		{
+			name:       "невалидные тренировки",
+			data:       "3000,Бег,15m;bad;1000,Йога,10m",
+			wantErrIdx: []string{"sub-entry 1", "sub-entry 2"},
+		},
+		{
+			name:       "лишний разделитель",
+			data:       "3000,Бег,15m;",
+			wantErrIdx: []string{"sub-entry 1"},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoMulti(tt.data, 75.0, 1.75)
+
+			if len(tt.wantErrIdx) > 0 {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				for _, idx := range tt.wantErrIdx {
+					assert.Contains(suite.T(), err.Error(), idx)
+				}
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTopCalorieActivity() {
+	tests := []struct {
+		name         string
+		entries      []string
+		wantActivity string
+		wantCal      float64
+		wantErr      string
+	}{
+		{
+			name:         "больше всего на беге",
+			entries:      []string{"6000,Ходьба,1h00m", "6000,Бег,1h00m", "40,Плавание,30m"},
+			wantActivity: "Бег",
+			wantCal:      511.875,
+		},
+		{
+			name:         "при равенстве выбирается первая",
+			entries:      []string{"7,Плавание,10m", "400,Велоспорт,10m"},
+			wantActivity: "Плавание",
+			wantCal:      52.5,
+		},
+		{
+			name:    "невалидная строка",
+			entries: []string{"6000,Бег,1h00m", "6000,Бег"},
+			wantErr: "entry 1: ",
+		},
+		{
+			name:    "нет тренировок",
+			entries: nil,
+			wantErr: "no trainings",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			activity, calories, err := TopCalorieActivity(tt.entries, 75.0, 1.75)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(suite.T(), err, tt.wantErr)
+				assert.Empty(suite.T(), activity)
+				assert.Zero(suite.T(), calories)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.wantActivity, activity)
+			assert.InDelta(suite.T(), tt.wantCal, calories, 1e-9)
+		})
+	}
+}
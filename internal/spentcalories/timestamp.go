@@ -0,0 +1,55 @@
+package spentcalories
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timestampLayouts содержит допустимые форматы отметки времени тренировки.
+// Форматы без часового пояса разбираются в местном времени.
+var timestampLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+}
+
+// parseTimestamp разбирает отметку времени тренировки в одном из форматов timestampLayouts.
+func parseTimestamp(s string) (time.Time, bool) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// ParseTrainingWithTime разбирает строку с данными о тренировке с необязательной отметкой времени.
+// Ожидает строку в формате "время,количество_шагов,тип_активности,продолжительность"
+// (например, "2024-01-02T10:00,5000,Бег,30m") или в формате parseTraining без отметки времени.
+// Поля разделяются Delimiter.
+// Время указывается в формате RFC3339 либо как "2006-01-02T15:04[:05]" в местном времени.
+// Как и ParseTraining, отклоняет строки для активности "Подъём по лестнице" с ErrFlightsRequired.
+// Возвращает отметку времени (нулевую, если она не указана), количество шагов, тип активности,
+// продолжительность и ошибку в случае невалидных данных или отметки времени в будущем.
+func ParseTrainingWithTime(data string) (time.Time, int, string, time.Duration, error) {
+	head, rest, found := strings.Cut(data, string(Delimiter))
+	timestamp, ok := parseTimestamp(head)
+	if !found || !ok {
+		steps, activity, duration, err := parseTraining(data)
+		return time.Time{}, steps, activity, duration, err
+	}
+
+	if timestamp.After(time.Now()) {
+		return time.Time{}, 0, "", 0, fmt.Errorf("timestamp must not be in the future, got: %s",
+			timestamp.Format(time.RFC3339))
+	}
+
+	steps, activity, duration, err := parseTraining(rest)
+	if err != nil {
+		return time.Time{}, 0, activity, 0, err
+	}
+
+	return timestamp, steps, activity, duration, nil
+}
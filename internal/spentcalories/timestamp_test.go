@@ -0,0 +1,84 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingWithTime() {
+	tests := []struct {
+		name         string
+		input        string
+		wantTime     time.Time
+		wantSteps    int
+		wantActivity string
+		wantDuration time.Duration
+		wantErr      bool
+	}{
+		{
+			name:         "без отметки времени",
+			input:        "5000,Бег,30m",
+			wantTime:     time.Time{},
+			wantSteps:    5000,
+			wantActivity: "Бег",
+			wantDuration: 30 * time.Minute,
+		},
+		{
+			name:         "отметка времени без секунд",
+			input:        "2024-01-02T10:00,5000,Бег,30m",
+			wantTime:     time.Date(2024, 1, 2, 10, 0, 0, 0, time.Local),
+			wantSteps:    5000,
+			wantActivity: "Бег",
+			wantDuration: 30 * time.Minute,
+		},
+		{
+			name:         "отметка времени RFC3339",
+			input:        "2024-01-02T10:00:00+03:00,3000,Ходьба,1h",
+			wantTime:     time.Date(2024, 1, 2, 7, 0, 0, 0, time.UTC),
+			wantSteps:    3000,
+			wantActivity: "Ходьба",
+			wantDuration: time.Hour,
+		},
+		{
+			name:    "отметка времени в будущем",
+			input:   "2999-01-02T10:00,5000,Бег,30m",
+			wantErr: true,
+		},
+		{
+			name:    "некорректная отметка времени",
+			input:   "2024-13-02T10:00,5000,Бег,30m",
+			wantErr: true,
+		},
+		{
+			name:    "отметка времени и некорректные данные",
+			input:   "2024-01-02T10:00,0,Бег,30m",
+			wantErr: true,
+		},
+		{
+			name:    "только отметка времени",
+			input:   "2024-01-02T10:00",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			gotTime, steps, activity, duration, err := ParseTrainingWithTime(tt.input)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.True(suite.T(), gotTime.IsZero())
+				assert.Equal(suite.T(), 0, steps)
+				assert.Equal(suite.T(), time.Duration(0), duration)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.True(suite.T(), tt.wantTime.Equal(gotTime), "получено %v, ожидается %v", gotTime, tt.wantTime)
+			assert.Equal(suite.T(), tt.wantSteps, steps)
+			assert.Equal(suite.T(), tt.wantActivity, activity)
+			assert.Equal(suite.T(), tt.wantDuration, duration)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package spentcalories
+
+import (
+	"fmt"
+)
+
+// UnitSystem определяет систему единиц измерения входных данных и отчёта.
+type UnitSystem int
+
+// Поддерживаемые системы единиц измерения.
+const (
+	Metric   UnitSystem = iota // килограммы, метры, километры.
+	Imperial                   // фунты, дюймы, мили.
+)
+
+// Константы для перевода имперских единиц в метрические.
+const (
+	kgInLb   = 0.45359237 // количество килограммов в фунте.
+	mInInch  = 0.0254     // количество метров в дюйме.
+	kmInMile = 1.609344   // количество километров в миле.
+)
+
+// TrainingInfoUnits формирует информационное сообщение о тренировке в выбранной системе единиц.
+// Принимает:
+//   - data: строка с данными о тренировке в формате "количество_шагов,тип_активности,продолжительность"
+//   - weight: вес пользователя в килограммах (Metric) или фунтах (Imperial)
+//   - height: рост пользователя в метрах (Metric) или дюймах (Imperial)
+//   - units: система единиц измерения
+//
+// Имперские значения переводятся в метрические, после чего используются обычные формулы расчёта.
+// В имперской системе дистанция выводится в милях, скорость в милях в час, а темп в минутах на милю.
+// Возвращает отформатированную строку с информацией о тренировке или ошибку в случае невалидных данных.
+func TrainingInfoUnits(data string, weight, height float64, units UnitSystem) (string, error) {
+	switch units {
+	case Metric:
+	case Imperial:
+		weight, height = weight*kgInLb, height*mInInch
+	default:
+		return "", fmt.Errorf("unknown unit system: %d", units)
+	}
+
+	result, err := TrainingData(data, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	opts := DefaultOptions()
+	opts.Units = units
+
+	return formatTraining(result, opts)
+}
+
+// TrainingInfoBoth формирует информационные сообщения о тренировке сразу в метрической
+// и имперской системах, например для вывода рядом.
+// Принимает те же данные о тренировке и пользователе, что и TrainingInfo; вес и рост
+// указываются в метрической системе. Показатели рассчитываются один раз, поэтому
+// оба сообщения описывают одни и те же значения.
+// Возвращает отформатированные строки или ошибку в случае невалидных данных.
+func TrainingInfoBoth(data string, weight, height float64) (metric string, imperial string, err error) {
+	result, err := TrainingData(data, weight, height)
+	if err != nil {
+		return "", "", err
+	}
+
+	opts := DefaultOptions()
+
+	metric, err = formatTraining(result, opts)
+	if err != nil {
+		return "", "", err
+	}
+
+	opts.Units = Imperial
+
+	imperial, err = formatTraining(result, opts)
+	if err != nil {
+		return "", "", err
+	}
+
+	return metric, imperial, nil
+}
@@ -0,0 +1,87 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoUnits() {
+	tests := []struct {
+		name    string
+		input   string
+		weight  float64
+		height  float64
+		units   UnitSystem
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "метрическая система совпадает с TrainingInfo",
+			input:   "6000,Ходьба,1h00m",
+			weight:  75.0,
+			height:  1.75,
+			units:   Metric,
+			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nТемп: 12.70 мин/км\nСожгли калорий: 177.19\nКаденс: 100.00 шаг/мин\n",
+			wantErr: false,
+		},
+		{
+			name:    "имперская система - ходьба",
+			input:   "6000,Ходьба,1h00m",
+			weight:  150.0,
+			height:  70.0,
+			units:   Imperial,
+			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 2.98 ми.\nСкорость: 2.98 миль/ч\nТемп: 20.11 мин/ми\nСожгли калорий: 163.31\nКаденс: 100.00 шаг/мин\n",
+			wantErr: false,
+		},
+		{
+			name:    "неизвестная система единиц",
+			input:   "6000,Ходьба,1h00m",
+			weight:  75.0,
+			height:  1.75,
+			units:   UnitSystem(42),
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "имперская система - некорректные данные",
+			input:   "6000,Ходьба",
+			weight:  150.0,
+			height:  70.0,
+			units:   Imperial,
+			want:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoUnits(tt.input, tt.weight, tt.height, tt.units)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoBoth() {
+	metric, imperial, err := TrainingInfoBoth("6000,Ходьба,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\n"+
+		"Темп: 12.70 мин/км\nСожгли калорий: 177.19\nКаденс: 100.00 шаг/мин\n", metric)
+	assert.Equal(suite.T(), "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 2.94 ми.\nСкорость: 2.94 миль/ч\n"+
+		"Темп: 20.44 мин/ми\nСожгли калорий: 177.19\nКаденс: 100.00 шаг/мин\n", imperial)
+
+	want, err := TrainingInfo("6000,Ходьба,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, metric)
+
+	metric, imperial, err = TrainingInfoBoth("6000,Ходьба", 75.0, 1.75)
+	assert.Error(suite.T(), err)
+	assert.Empty(suite.T(), metric)
+	assert.Empty(suite.T(), imperial)
+}
@@ -0,0 +1,41 @@
+package spentcalories
+
+import (
+	"fmt"
+)
+
+// LineError описывает ошибку в одной строке набора данных о тренировках.
+type LineError struct {
+	Index int   // индекс строки в наборе, начиная с нуля.
+	Err   error // ошибка проверки строки.
+}
+
+// Error возвращает текст ошибки с номером строки, начиная с единицы.
+func (e LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Index+1, e.Err)
+}
+
+// Unwrap возвращает исходную ошибку проверки строки, поэтому для LineError работает errors.Is.
+func (e LineError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateBatch проверяет набор строк с данными о тренировках перед обработкой.
+// Каждая строка проверяется функцией ValidateTraining.
+// Возвращает ошибки невалидных строк в исходном порядке, но не более maxErrors;
+// если maxErrors не положителен, возвращаются все ошибки. Для корректного набора возвращает nil.
+func ValidateBatch(lines []string, maxErrors int) []LineError {
+	var errs []LineError
+
+	for i, line := range lines {
+		if maxErrors > 0 && len(errs) >= maxErrors {
+			break
+		}
+
+		if err := ValidateTraining(line); err != nil {
+			errs = append(errs, LineError{Index: i, Err: err})
+		}
+	}
+
+	return errs
+}
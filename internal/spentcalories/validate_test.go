@@ -0,0 +1,69 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestValidateBatch() {
+	lines := []string{
+		"6000,Бег,1h00m",
+		"6000,Бег",
+		"40,Плавание,30m",
+		"abc,Ходьба,1h",
+		"6000,Йога,1h00m",
+	}
+
+	tests := []struct {
+		name        string
+		lines       []string
+		maxErrors   int
+		wantIndexes []int
+	}{
+		{
+			name:        "все ошибки",
+			lines:       lines,
+			maxErrors:   10,
+			wantIndexes: []int{1, 3, 4},
+		},
+		{
+			name:        "первые две ошибки",
+			lines:       lines,
+			maxErrors:   2,
+			wantIndexes: []int{1, 3},
+		},
+		{
+			name:        "без ограничения",
+			lines:       lines,
+			maxErrors:   0,
+			wantIndexes: []int{1, 3, 4},
+		},
+		{
+			name:        "корректный набор",
+			lines:       []string{"6000,Бег,1h00m", "3000,Ходьба,30m"},
+			maxErrors:   10,
+			wantIndexes: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			errs := ValidateBatch(tt.lines, tt.maxErrors)
+
+			var gotIndexes []int
+			for _, err := range errs {
+				gotIndexes = append(gotIndexes, err.Index)
+			}
+
+			assert.Equal(suite.T(), tt.wantIndexes, gotIndexes)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestLineError() {
+	errs := ValidateBatch([]string{"6000,Бег,1h00m", "abc,Ходьба,1h"}, 1)
+
+	assert.Len(suite.T(), errs, 1)
+	assert.ErrorIs(suite.T(), errs[0], ErrInvalidSteps)
+	assert.EqualError(suite.T(), errs[0],
+		`line 2: parsing steps failed: strconv.Atoi: parsing "abc": invalid syntax`)
+}
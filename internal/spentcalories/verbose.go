@@ -0,0 +1,119 @@
+package spentcalories
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// verboseLabels содержит подписи первого поля строки и длины отрезка, который ему соответствует,
+// для каждого типа активности в подробном отчёте.
+var verboseLabels = map[string]struct {
+	count   string
+	segment string
+}{
+	running:  {count: "Количество шагов", segment: "Длина шага"},
+	walking:  {count: "Количество шагов", segment: "Длина шага"},
+	cycling:  {count: "Количество оборотов педалей", segment: "Длина оборота"},
+	swimming: {count: "Количество бассейнов", segment: "Длина бассейна"},
+	stairs:   {count: "Количество шагов", segment: "Длина шага"},
+}
+
+// caloriesFormula возвращает описание формулы, по которой рассчитываются калории
+// для указанного типа активности.
+func caloriesFormula(activity string) string {
+	if met, ok := METValues[activity]; UseMETModel && ok {
+		return fmt.Sprintf("MET (%.2f) * вес * часы", met)
+	}
+
+	base := "вес * дистанция"
+
+	switch activity {
+	case walking:
+		return fmt.Sprintf("%s * %.2f", base, defaultCalculator.WalkingCoefficient)
+	case cycling:
+		return fmt.Sprintf("%s * %.2f", base, cyclingCaloriesCoefficient)
+	case swimming:
+		return fmt.Sprintf("%s * %.2f", base, swimmingCaloriesCoefficient)
+	case stairs:
+		return fmt.Sprintf("вес * дистанция * %.2f + вес * пролёты * %.4f",
+			walkingCaloriesCoefficient, stairsKcalPerKgPerFlight)
+	default:
+		return base
+	}
+}
+
+// TrainingInfoVerbose формирует подробный отчёт о тренировке, который объясняет расчёт:
+// разобранное количество шагов, длину шага, дистанцию, скорость, формулу расчёта калорий
+// и итоговое количество калорий.
+// Принимает те же параметры, что и TrainingInfo, и выполняет те же проверки.
+// Показатели рассчитываются так же, как в TrainingData.
+// Возвращает отформатированную строку или ошибку в случае невалидных данных.
+func TrainingInfoVerbose(data string, weight, height float64) (string, error) {
+	if err := checkBody(weight, height); err != nil {
+		return "", err
+	}
+
+	steps, activity, duration, flights, err := parseTrainingFlights(data, Delimiter, height)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := trainingResult(activity, steps, duration, flights, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	labels := verboseLabels[activity]
+	segment := segmentLength(activity, height)
+
+	var report strings.Builder
+	fmt.Fprintf(&report, "Тип тренировки: %s\n", result.Activity)
+	fmt.Fprintf(&report, "%s: %d\n", labels.count, steps)
+	fmt.Fprintf(&report, "%s: %.2f м\n", labels.segment, segment)
+	fmt.Fprintf(&report, "Дистанция: %.2f км (%d * %.2f м / %d)\n", result.DistanceKm, steps, segment, MInKm)
+	fmt.Fprintf(&report, "Длительность: %.2f ч.\n", result.Duration.Hours())
+	fmt.Fprintf(&report, "Скорость: %.2f км/ч (дистанция / длительность)\n", result.SpeedKmh)
+	fmt.Fprintf(&report, "Формула: %s\n", caloriesFormula(activity))
+	fmt.Fprintf(&report, "Сожгли калорий: %.2f\n", result.Calories)
+
+	return report.String(), nil
+}
+
+// distanceFormula возвращает описание формулы, по которой рассчитывается дистанция
+// для встроенного типа активности.
+func distanceFormula(activity string) string {
+	switch activity {
+	case cycling:
+		return fmt.Sprintf("количество оборотов педалей * %.2f м / %d", cyclingLenRevolution, MInKm)
+	case swimming:
+		return fmt.Sprintf("количество бассейнов * %.2f м / %d", PoolLengthM, MInKm)
+	case stairs:
+		return fmt.Sprintf("количество шагов * %.2f м / %d", LenStep, MInKm)
+	default:
+		return fmt.Sprintf("количество шагов * %.2f * рост в метрах / %d",
+			defaultCalculator.stepLengthCoefficient(activity), MInKm)
+	}
+}
+
+// FormulaDescription возвращает описание формул и констант, по которым рассчитываются дистанция,
+// скорость и калории для типа активности activity, например для аудита расчётов.
+// Описание строится из тех же констант и настроек пакета, что и расчёт, поэтому учитывает
+// PoolLengthM и UseMETModel. Для типов активности, зарегистрированных RegisterActivity,
+// сообщает, что используется пользовательская формула.
+// Возвращает описание или ошибку для неизвестного типа активности.
+func FormulaDescription(activity string) (string, error) {
+	activity = normalizeActivity(activity)
+
+	if !slices.Contains(activities, activity) {
+		if _, ok := customActivity(activity); ok {
+			return fmt.Sprintf("Тип тренировки: %s\nКалории: пользовательская формула, "+
+				"зарегистрированная RegisterActivity\n", activity), nil
+		}
+
+		return "", unknownActivityError(activity)
+	}
+
+	return fmt.Sprintf("Тип тренировки: %s\nДистанция, км: %s\nСкорость, км/ч: дистанция / длительность в часах\n"+
+		"Калории: %s\n", activity, distanceFormula(activity), caloriesFormula(activity)), nil
+}
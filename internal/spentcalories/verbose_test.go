@@ -0,0 +1,140 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoVerbose() {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "бег",
+			input: "6000,Бег,1h00m",
+			want: "Тип тренировки: Бег\nКоличество шагов: 6000\nДлина шага: 1.14 м\n" +
+				"Дистанция: 6.83 км (6000 * 1.14 м / 1000)\nДлительность: 1.00 ч.\n" +
+				"Скорость: 6.83 км/ч (дистанция / длительность)\n" +
+				"Формула: вес * дистанция\nСожгли калорий: 511.88\n",
+		},
+		{
+			name:  "ходьба",
+			input: "3000,Ходьба,30m",
+			want: "Тип тренировки: Ходьба\nКоличество шагов: 3000\nДлина шага: 0.79 м\n" +
+				"Дистанция: 2.36 км (3000 * 0.79 м / 1000)\nДлительность: 0.50 ч.\n" +
+				"Скорость: 4.72 км/ч (дистанция / длительность)\n" +
+				"Формула: вес * дистанция * 0.50\nСожгли калорий: 88.59\n",
+		},
+		{
+			name:  "плавание",
+			input: "40,Плавание,30m",
+			want: "Тип тренировки: Плавание\nКоличество бассейнов: 40\nДлина бассейна: 25.00 м\n" +
+				"Дистанция: 1.00 км (40 * 25.00 м / 1000)\nДлительность: 0.50 ч.\n" +
+				"Скорость: 2.00 км/ч (дистанция / длительность)\n" +
+				"Формула: вес * дистанция * 4.00\nСожгли калорий: 300.00\n",
+		},
+		{
+			name:    "некорректные данные",
+			input:   "6000,Бег",
+			wantErr: true,
+		},
+		{
+			name:    "неизвестный тип тренировки",
+			input:   "6000,Йога,1h00m",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoVerbose(tt.input, 75.0, 1.75)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoVerboseMETModel() {
+	defer func(use bool) { UseMETModel = use }(UseMETModel)
+	UseMETModel = true
+
+	got, err := TrainingInfoVerbose("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Формула: MET (8.00) * вес * часы\nСожгли калорий: 600.00\n")
+}
+
+func (suite *SpentCaloriesTestSuite) TestFormulaDescription() {
+	tests := []struct {
+		name     string
+		activity string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "бег",
+			activity: "Бег",
+			want: "Тип тренировки: Бег\nДистанция, км: количество шагов * 0.65 * рост в метрах / 1000\n" +
+				"Скорость, км/ч: дистанция / длительность в часах\nКалории: вес * дистанция\n",
+		},
+		{
+			name:     "ходьба",
+			activity: "ходьба",
+			want: "Тип тренировки: Ходьба\nДистанция, км: количество шагов * 0.45 * рост в метрах / 1000\n" +
+				"Скорость, км/ч: дистанция / длительность в часах\nКалории: вес * дистанция * 0.50\n",
+		},
+		{
+			name:     "велоспорт",
+			activity: "Велоспорт",
+			want: "Тип тренировки: Велоспорт\nДистанция, км: количество оборотов педалей * 5.00 м / 1000\n" +
+				"Скорость, км/ч: дистанция / длительность в часах\nКалории: вес * дистанция * 0.35\n",
+		},
+		{
+			name:     "плавание",
+			activity: "Плавание",
+			want: "Тип тренировки: Плавание\nДистанция, км: количество бассейнов * 25.00 м / 1000\n" +
+				"Скорость, км/ч: дистанция / длительность в часах\nКалории: вес * дистанция * 4.00\n",
+		},
+		{
+			name:     "неизвестный тип тренировки",
+			activity: "Йога",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := FormulaDescription(tt.activity)
+
+			if tt.wantErr {
+				assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestFormulaDescriptionFollowsSettings() {
+	defer func(use bool) { UseMETModel = use }(UseMETModel)
+	defer func(length float64) { PoolLengthM = length }(PoolLengthM)
+
+	UseMETModel = true
+	PoolLengthM = 50
+
+	got, err := FormulaDescription("Плавание")
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "количество бассейнов * 50.00 м / 1000")
+	assert.Contains(suite.T(), got, "Калории: MET (6.00) * вес * часы")
+}
@@ -0,0 +1,62 @@
+package spentcalories
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Коэффициенты формулы Дэниелса - Гилберта (J. Daniels, J. Gilbert, "Oxygen Power", 1979).
+const (
+	danielsVO2Intercept  = -4.60     // свободный член потребления кислорода.
+	danielsVO2Linear     = 0.182258  // коэффициент при скорости в м/мин.
+	danielsVO2Quadratic  = 0.000104  // коэффициент при квадрате скорости.
+	danielsFractionBase  = 0.8       // минимальная доля VO2max, которую бегун держит на длинной дистанции.
+	danielsFractionFast  = 0.1894393 // вклад первой экспоненты в долю VO2max.
+	danielsDecayFast     = 0.012778  // показатель первой экспоненты в 1/мин.
+	danielsFractionSlow  = 0.2989558 // вклад второй экспоненты в долю VO2max.
+	danielsDecaySlow     = 0.1932605 // показатель второй экспоненты в 1/мин.
+	minVO2MaxRunDuration = 3 * time.Minute
+)
+
+// EstimateVO2Max оценивает максимальное потребление кислорода (VO2max) в мл/кг/мин по результату забега.
+// Используется формула Дэниелса - Гилберта ("Oxygen Power", 1979), на которой основаны таблицы VDOT:
+// потребление кислорода на скорости забега v в м/мин, VO2 = -4.60 + 0.182258*v + 0.000104*v²,
+// делится на долю VO2max, которую бегун способен удерживать t минут,
+// 0.8 + 0.1894393*e^(-0.012778*t) + 0.2989558*e^(-0.1932605*t).
+// Формула не зависит от возраста и пола: они только проверяются, чтобы оценку можно было
+// сравнивать с возрастными нормами.
+// Принимает:
+//   - distanceKm: дистанция забега в километрах (должна быть > 0)
+//   - duration: время забега (должно быть не меньше трёх минут, на меньших формула неприменима)
+//   - age: возраст пользователя в годах (должен быть > 0)
+//   - sex: пол пользователя
+//
+// Возвращает оценку VO2max или ошибку в случае невалидных входных данных.
+func EstimateVO2Max(distanceKm float64, duration time.Duration, age int, sex Sex) (float64, error) {
+	if distanceKm <= 0.0 {
+		return 0.0, fmt.Errorf("distance must be greater than zero, got: %f", distanceKm)
+	}
+
+	if duration < minVO2MaxRunDuration {
+		return 0.0, fmt.Errorf("duration must be at least %s, got: %s", minVO2MaxRunDuration, duration)
+	}
+
+	if age <= 0 {
+		return 0.0, fmt.Errorf("age must be greater than zero, got: %d", age)
+	}
+
+	if sex < SexUnspecified || sex > Female {
+		return 0.0, fmt.Errorf("unknown sex: %d", sex)
+	}
+
+	minutes := duration.Minutes()
+	velocity := distanceKm * MInKm / minutes
+
+	vo2 := danielsVO2Intercept + danielsVO2Linear*velocity + danielsVO2Quadratic*velocity*velocity
+	fraction := danielsFractionBase +
+		danielsFractionFast*math.Exp(-danielsDecayFast*minutes) +
+		danielsFractionSlow*math.Exp(-danielsDecaySlow*minutes)
+
+	return vo2 / fraction, nil
+}
@@ -0,0 +1,43 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Ожидаемые значения совпадают с таблицами VDOT Дэниелса с точностью до десятых.
+func (suite *SpentCaloriesTestSuite) TestEstimateVO2Max() {
+	tests := []struct {
+		name       string
+		distanceKm float64
+		duration   time.Duration
+		age        int
+		sex        Sex
+		want       float64
+		wantErr    bool
+	}{
+		{name: "5 км за 20 минут", distanceKm: 5, duration: 20 * time.Minute, age: 30, sex: Male, want: 49.8},
+		{name: "10 км за 50 минут", distanceKm: 10, duration: 50 * time.Minute, age: 40, sex: Female, want: 40.0},
+		{name: "марафон за 3 часа", distanceKm: 42.195, duration: 3 * time.Hour, age: 35, want: 53.5},
+		{name: "нулевая дистанция", distanceKm: 0, duration: 20 * time.Minute, age: 30, wantErr: true},
+		{name: "слишком короткий забег", distanceKm: 1, duration: 2 * time.Minute, age: 30, wantErr: true},
+		{name: "нулевой возраст", distanceKm: 5, duration: 20 * time.Minute, age: 0, wantErr: true},
+		{name: "неизвестный пол", distanceKm: 5, duration: 20 * time.Minute, age: 30, sex: Sex(5), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := EstimateVO2Max(tt.distanceKm, tt.duration, tt.age, tt.sex)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 0.05)
+		})
+	}
+}
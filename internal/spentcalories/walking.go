@@ -0,0 +1,91 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// elevationCaloriesCoefficient - дополнительный расход калорий на каждый килограмм веса
+// и метр набора высоты. Получен из работы против силы тяжести 9.81 Дж/(кг*м),
+// переведённой в килокалории (1 ккал = 4184 Дж), при КПД мышц около 25%:
+// 9.81 / 4184 / 0.25 ≈ 0.0094 ккал/(кг*м).
+const elevationCaloriesCoefficient = 0.0094
+
+// WalkingSpentCaloriesElevation рассчитывает количество калорий, потраченных при ходьбе с набором высоты.
+// К результату WalkingSpentCalories добавляется elevationCaloriesCoefficient ккал
+// на каждый килограмм веса и метр набора высоты.
+// Принимает те же параметры, что и WalkingSpentCalories, и набор высоты elevationGainM в метрах.
+// Отрицательный набор высоты (спуск) уменьшает добавку, но расход не опускается ниже
+// расхода при ходьбе по ровной поверхности.
+// Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных.
+func WalkingSpentCaloriesElevation(steps int, weight, height float64, duration time.Duration,
+	elevationGainM float64) (float64, error) {
+	calories, err := WalkingSpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return 0.0, err
+	}
+
+	return calories + max(elevationCaloriesCoefficient*weight*elevationGainM, 0.0), nil
+}
+
+// Surface определяет тип поверхности, по которой проходила ходьба.
+type Surface int
+
+// Поддерживаемые типы поверхности.
+const (
+	Road      Surface = iota // асфальт или другая твёрдая поверхность, используется по умолчанию.
+	Trail                    // грунтовая тропа.
+	Sand                     // песок.
+	Treadmill                // беговая дорожка.
+)
+
+// surfaceMultipliers содержит множители расхода калорий при ходьбе для каждого типа поверхности
+// относительно твёрдой поверхности:
+//   - Trail: 1.15 - неровный грунт и трава увеличивают затраты примерно на 10-20%
+//     (Soule, Goldman, 1972);
+//   - Sand: 1.8 - по мягкому песку затраты выше в 1.6-2.5 раза (Lejeune и др., 1998),
+//     взято консервативное значение;
+//   - Treadmill: 0.95 - на дорожке нет сопротивления воздуха и неровностей,
+//     поэтому затраты немного ниже (Jones, Doust, 1996).
+var surfaceMultipliers = map[Surface]float64{
+	Road:      1.0,
+	Trail:     1.15,
+	Sand:      1.8,
+	Treadmill: 0.95,
+}
+
+// WalkingSpentCaloriesSurface рассчитывает количество калорий, потраченных при ходьбе по поверхности s.
+// Результат WalkingSpentCalories умножается на множитель поверхности из surfaceMultipliers.
+// Принимает те же параметры, что и WalkingSpentCalories, и тип поверхности s.
+// Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных
+// или неизвестного типа поверхности.
+func WalkingSpentCaloriesSurface(steps int, weight, height float64, d time.Duration, s Surface) (float64, error) {
+	multiplier, ok := surfaceMultipliers[s]
+	if !ok {
+		return 0.0, fmt.Errorf("unknown surface: %d", s)
+	}
+
+	calories, err := WalkingSpentCalories(steps, weight, height, d)
+	if err != nil {
+		return 0.0, err
+	}
+
+	return calories * multiplier, nil
+}
+
+// WalkingSpentCaloriesLoaded рассчитывает количество калорий, потраченных при ходьбе с грузом,
+// например с утяжелённым жилетом или рюкзаком. В формуле WalkingSpentCalories вместо веса
+// пользователя используется общий вес: вес тела bodyWeight плюс вес груза loadKg.
+// Принимает те же параметры, что и WalkingSpentCalories, и вес груза в килограммах (должен быть >= 0).
+// Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных.
+func WalkingSpentCaloriesLoaded(steps int, bodyWeight, loadKg, height float64, d time.Duration) (float64, error) {
+	if bodyWeight <= 0.0 {
+		return 0.0, fmt.Errorf("weight must be greater than zero, got: %f", bodyWeight)
+	}
+
+	if loadKg < 0.0 {
+		return 0.0, fmt.Errorf("load must not be negative, got: %f", loadKg)
+	}
+
+	return WalkingSpentCalories(steps, bodyWeight+loadKg, height, d)
+}
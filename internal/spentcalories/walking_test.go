@@ -0,0 +1,104 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestWalkingSpentCaloriesElevation() {
+	tests := []struct {
+		name      string
+		steps     int
+		weight    float64
+		elevation float64
+		wantCal   float64
+		wantErr   bool
+	}{
+		{name: "ровная поверхность", steps: 6000, weight: 75.0, elevation: 0, wantCal: 177.1875},
+		{name: "подъём на 100 метров", steps: 6000, weight: 75.0, elevation: 100, wantCal: 247.6875},
+		{name: "подъём на 10 метров", steps: 6000, weight: 60.0, elevation: 10, wantCal: 147.39},
+		{name: "спуск не ниже ровной поверхности", steps: 6000, weight: 75.0, elevation: -100, wantCal: 177.1875},
+		{name: "ноль шагов", steps: 0, weight: 75.0, elevation: 100, wantErr: true},
+		{name: "нулевой вес", steps: 6000, weight: 0, elevation: 100, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := WalkingSpentCaloriesElevation(tt.steps, tt.weight, 1.75, time.Hour, tt.elevation)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantCal, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestWalkingSpentCaloriesSurface() {
+	tests := []struct {
+		name    string
+		steps   int
+		surface Surface
+		want    float64
+		wantErr bool
+	}{
+		{name: "асфальт", steps: 6000, surface: Road, want: 177.1875},
+		{name: "тропа", steps: 6000, surface: Trail, want: 177.1875 * 1.15},
+		{name: "песок", steps: 6000, surface: Sand, want: 177.1875 * 1.8},
+		{name: "беговая дорожка", steps: 6000, surface: Treadmill, want: 177.1875 * 0.95},
+		{name: "неизвестная поверхность", steps: 6000, surface: Surface(10), wantErr: true},
+		{name: "нулевые шаги", steps: 0, surface: Sand, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := WalkingSpentCaloriesSurface(tt.steps, 75.0, 1.75, time.Hour, tt.surface)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestWalkingSpentCaloriesLoaded() {
+	tests := []struct {
+		name       string
+		steps      int
+		bodyWeight float64
+		loadKg     float64
+		want       float64
+		wantErr    bool
+	}{
+		{name: "без груза", steps: 6000, bodyWeight: 75.0, loadKg: 0, want: 177.1875},
+		{name: "рюкзак 15 кг", steps: 6000, bodyWeight: 75.0, loadKg: 15, want: 212.625},
+		{name: "отрицательный груз", steps: 6000, bodyWeight: 75.0, loadKg: -5, wantErr: true},
+		{name: "нулевой вес тела", steps: 6000, bodyWeight: 0, loadKg: 15, wantErr: true},
+		{name: "нулевые шаги", steps: 0, bodyWeight: 75.0, loadKg: 15, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := WalkingSpentCaloriesLoaded(tt.steps, tt.bodyWeight, tt.loadKg, 1.75, time.Hour)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Zero(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
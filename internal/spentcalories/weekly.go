@@ -0,0 +1,21 @@
+package spentcalories
+
+// daysInWeek - количество дней в неделе.
+const daysInWeek = 7
+
+// ProjectWeeklyCalories оценивает количество калорий, которое будет потрачено за неделю,
+// по среднему количеству калорий в день dailyAvg.
+func ProjectWeeklyCalories(dailyAvg float64) float64 {
+	return dailyAvg * daysInWeek
+}
+
+// WeeklyCaloriesFromDays рассчитывает фактическое количество калорий за неделю
+// как сумму калорий за каждый день из days. Для пустого days возвращает 0.
+func WeeklyCaloriesFromDays(days []float64) float64 {
+	var total float64
+	for _, calories := range days {
+		total += calories
+	}
+
+	return total
+}
@@ -0,0 +1,52 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestProjectWeeklyCalories() {
+	tests := []struct {
+		name     string
+		dailyAvg float64
+		want     float64
+	}{
+		{name: "среднее значение", dailyAvg: 350.5, want: 2453.5},
+		{name: "нулевое значение", dailyAvg: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.InDelta(suite.T(), tt.want, ProjectWeeklyCalories(tt.dailyAvg), 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestWeeklyCaloriesFromDays() {
+	tests := []struct {
+		name string
+		days []float64
+		want float64
+	}{
+		{
+			name: "полная неделя",
+			days: []float64{300, 450, 0, 520.5, 310, 600, 200},
+			want: 2380.5,
+		},
+		{
+			name: "неполная неделя",
+			days: []float64{300, 450},
+			want: 750,
+		},
+		{
+			name: "нет данных",
+			days: nil,
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.InDelta(suite.T(), tt.want, WeeklyCaloriesFromDays(tt.days), 1e-9)
+		})
+	}
+}
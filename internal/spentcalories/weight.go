@@ -0,0 +1,23 @@
+package spentcalories
+
+import (
+	"fmt"
+)
+
+// ScaleCaloriesByWeight пересчитывает количество калорий calories, рассчитанное для веса oldWeight,
+// на новый вес newWeight, не разбирая данные о тренировке заново.
+// Формулы по шагам и дистанции, в том числе для подъёма по лестнице, и модель MET линейны по весу,
+// поэтому калории умножаются на отношение newWeight / oldWeight. Результаты CaloriesByHeartRate
+// и WalkingSpentCaloriesLoaded от веса зависят нелинейно, и пересчитывать их так нельзя.
+// Возвращает пересчитанные калории или ошибку, если какой-то из весов не положителен.
+func ScaleCaloriesByWeight(calories, oldWeight, newWeight float64) (float64, error) {
+	if oldWeight <= 0.0 {
+		return 0.0, fmt.Errorf("old weight must be greater than zero, got: %f", oldWeight)
+	}
+
+	if newWeight <= 0.0 {
+		return 0.0, fmt.Errorf("new weight must be greater than zero, got: %f", newWeight)
+	}
+
+	return calories * newWeight / oldWeight, nil
+}
@@ -0,0 +1,50 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestScaleCaloriesByWeight() {
+	tests := []struct {
+		name      string
+		calories  float64
+		oldWeight float64
+		newWeight float64
+		want      float64
+		wantErr   bool
+	}{
+		{name: "вес снизился", calories: 511.875, oldWeight: 75.0, newWeight: 60.0, want: 409.5},
+		{name: "вес не изменился", calories: 511.875, oldWeight: 75.0, newWeight: 75.0, want: 511.875},
+		{name: "нулевой старый вес", calories: 511.875, oldWeight: 0, newWeight: 60.0, wantErr: true},
+		{name: "отрицательный новый вес", calories: 511.875, oldWeight: 75.0, newWeight: -60.0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := ScaleCaloriesByWeight(tt.calories, tt.oldWeight, tt.newWeight)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestScaleCaloriesByWeightMatchesRecalculation() {
+	for _, data := range []string{"6000,Бег,1h00m", "6000,Ходьба,1h00m", "40,Плавание,30m", "400,Велоспорт,10m"} {
+		old, err := TrainingData(data, 75.0, 1.75)
+		assert.NoError(suite.T(), err)
+
+		recalculated, err := TrainingData(data, 62.0, 1.75)
+		assert.NoError(suite.T(), err)
+
+		scaled, err := ScaleCaloriesByWeight(old.Calories, 75.0, 62.0)
+		assert.NoError(suite.T(), err)
+		assert.InDelta(suite.T(), recalculated.Calories, scaled, 1e-9, data)
+	}
+}
@@ -0,0 +1,49 @@
+package spentcalories
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Константы модели сопротивления воздуха при езде на велосипеде.
+// Сила сопротивления F = 0.5 * ρ * CdA * u², где u - скорость велосипедиста относительно воздуха.
+// Дополнительная работа против ветра переводится в калории с учётом КПД мышц.
+const (
+	airDensity              = 1.225 // плотность воздуха ρ на уровне моря при 15 °C, кг/м³.
+	cyclingDragArea         = 0.4   // CdA: коэффициент сопротивления Cd ≈ 0.9 на площадь ≈ 0.45 м², посадка на шоссейном руле.
+	cyclingMuscleEfficiency = 0.25  // КПД мышц при езде на велосипеде.
+	kmhInMs                 = 3.6   // количество км/ч в одном м/с.
+)
+
+// CyclingSpentCaloriesWind рассчитывает количество калорий, потраченных при езде на велосипеде с ветром.
+// Базовый расход рассчитывается по дистанции так же, как в CyclingSpentCalories. К нему добавляется
+// разница работы против сопротивления воздуха с ветром и без ветра:
+// 0.5 * ρ * CdA * ((v + w)*|v + w| - v²) * дистанция / КПД, где v - скорость велосипедиста,
+// а w - скорость встречного ветра. Встречный ветер увеличивает расход, попутный
+// (отрицательное значение headwindKmh) уменьшает, но не ниже нуля.
+// Принимает:
+//   - distanceKm: дистанция в километрах (должна быть > 0)
+//   - weight: вес пользователя в килограммах (должен быть > 0)
+//   - d: продолжительность активности (должна быть > 0)
+//   - headwindKmh: скорость встречного ветра в км/ч, отрицательная для попутного ветра
+//
+// Возвращает количество потраченных калорий или ошибку в случае невалидных входных данных.
+func CyclingSpentCaloriesWind(distanceKm, weight float64, d time.Duration, headwindKmh float64) (float64, error) {
+	if math.IsNaN(headwindKmh) || math.IsInf(headwindKmh, 0) {
+		return 0.0, fmt.Errorf("headwind must be a finite number, got: %f", headwindKmh)
+	}
+
+	calories, err := RunningCaloriesByDistance(distanceKm, weight, d)
+	if err != nil {
+		return 0.0, err
+	}
+
+	speed := distanceKm / d.Hours() / kmhInMs
+	airSpeed := speed + headwindKmh/kmhInMs
+
+	dragForceDelta := 0.5 * airDensity * cyclingDragArea * (airSpeed*math.Abs(airSpeed) - speed*speed)
+	dragCalories := dragForceDelta * distanceKm * MInKm / cyclingMuscleEfficiency / (kJInKcal * 1000)
+
+	return max(calories*cyclingCaloriesCoefficient+dragCalories, 0.0), nil
+}
@@ -0,0 +1,54 @@
+package spentcalories
+
+import (
+	"math"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCyclingSpentCaloriesWind() {
+	tests := []struct {
+		name        string
+		distanceKm  float64
+		weight      float64
+		duration    time.Duration
+		headwindKmh float64
+		want        float64
+		wantErr     bool
+	}{
+		{name: "без ветра", distanceKm: 20, weight: 75.0, duration: time.Hour, headwindKmh: 0, want: 525},
+		{name: "встречный ветер", distanceKm: 20, weight: 75.0, duration: time.Hour, headwindKmh: 10, want: 705.73},
+		{name: "попутный ветер", distanceKm: 20, weight: 75.0, duration: time.Hour, headwindKmh: -10, want: 416.56},
+		{name: "ураганный попутный ветер", distanceKm: 20, weight: 75.0, duration: time.Hour, headwindKmh: -200, want: 0},
+		{name: "нулевая дистанция", distanceKm: 0, weight: 75.0, duration: time.Hour, wantErr: true},
+		{name: "нулевой вес", distanceKm: 20, weight: 0, duration: time.Hour, wantErr: true},
+		{name: "нулевая продолжительность", distanceKm: 20, weight: 75.0, duration: 0, wantErr: true},
+		{name: "бесконечный ветер", distanceKm: 20, weight: 75.0, duration: time.Hour, headwindKmh: math.Inf(1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CyclingSpentCaloriesWind(tt.distanceKm, tt.weight, tt.duration, tt.headwindKmh)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 0.01)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestCyclingSpentCaloriesWindMatchesCycling() {
+	want, err := CyclingSpentCalories(4000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+
+	got, err := CyclingSpentCaloriesWind(cyclingDistance(4000), 75.0, time.Hour, 0)
+	assert.NoError(suite.T(), err)
+
+	assert.InDelta(suite.T(), want, got, 1e-9)
+}
@@ -0,0 +1,20 @@
+package spentcalories
+
+import (
+	"fmt"
+	"io"
+)
+
+// WriteTrainingInfo записывает в w то же информационное сообщение о тренировке, что и TrainingInfo,
+// не собирая его в памяти. Удобно при записи большого количества отчётов в файл.
+// Принимает те же параметры, что и TrainingInfo.
+// Ошибки разбора и расчёта возвращаются с префиксом "training data",
+// ошибки записи в w - с префиксом "write training info". Исходная ошибка доступна через errors.Is.
+func WriteTrainingInfo(w io.Writer, data string, weight, height float64) error {
+	result, err := TrainingData(data, weight, height)
+	if err != nil {
+		return fmt.Errorf("training data: %w", err)
+	}
+
+	return writeTraining(w, result, DefaultOptions())
+}
@@ -0,0 +1,45 @@
+package spentcalories
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// errWriter - writer, который всегда возвращает ошибку.
+type errWriter struct{}
+
+var errWriteFailed = errors.New("write failed")
+
+func (errWriter) Write([]byte) (int, error) {
+	return 0, errWriteFailed
+}
+
+func (suite *SpentCaloriesTestSuite) TestWriteTrainingInfo() {
+	input := "6000,Ходьба,1h00m"
+
+	suite.Run("совпадает с TrainingInfo", func() {
+		want, err := TrainingInfo(input, 75.0, 1.75)
+		assert.NoError(suite.T(), err)
+
+		var got strings.Builder
+		err = WriteTrainingInfo(&got, input, 75.0, 1.75)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), want, got.String())
+	})
+
+	suite.Run("ошибка разбора", func() {
+		var got strings.Builder
+		err := WriteTrainingInfo(&got, "6000,Ходьба", 75.0, 1.75)
+		assert.ErrorIs(suite.T(), err, ErrInvalidFormat)
+		assert.Contains(suite.T(), err.Error(), "training data")
+		assert.Empty(suite.T(), got.String())
+	})
+
+	suite.Run("ошибка записи", func() {
+		err := WriteTrainingInfo(errWriter{}, input, 75.0, 1.75)
+		assert.ErrorIs(suite.T(), err, errWriteFailed)
+		assert.Contains(suite.T(), err.Error(), "write training info")
+	})
+}
@@ -0,0 +1,52 @@
+package spentcalories
+
+// Коды зон скорости бега, которые возвращает SpeedZone. Это стабильные значения,
+// по которым клиенты могут выбирать локализованное название зоны.
+const (
+	ZoneRecovery = "recovery" // восстановительный бег.
+	ZoneEasy     = "easy"     // лёгкий бег.
+	ZoneTempo    = "tempo"    // темповый бег.
+	ZoneInterval = "interval" // интервальный бег.
+)
+
+// SpeedZoneThresholds задаёт нижние границы зон скорости бега в км/ч.
+// Скорость ниже Easy относится к восстановительной зоне.
+type SpeedZoneThresholds struct {
+	Easy     float64 // скорость, начиная с которой бег считается лёгким.
+	Tempo    float64 // скорость, начиная с которой бег считается темповым.
+	Interval float64 // скорость, начиная с которой бег считается интервальным.
+}
+
+// DefaultSpeedZoneThresholds возвращает границы зон скорости для бегуна-любителя:
+// лёгкий бег с 8 км/ч, темповый с 11 км/ч и интервальный с 14 км/ч.
+func DefaultSpeedZoneThresholds() SpeedZoneThresholds {
+	return SpeedZoneThresholds{
+		Easy:     8.0,
+		Tempo:    11.0,
+		Interval: 14.0,
+	}
+}
+
+// SpeedZones задаёт границы зон скорости, которые используют SpeedZone и отчёт о беговой тренировке.
+// Границы можно переопределить под уровень подготовки пользователя.
+var SpeedZones = DefaultSpeedZoneThresholds()
+
+// Zone возвращает код зоны скорости бега speedKmh с границами t:
+// ZoneInterval, ZoneTempo, ZoneEasy или ZoneRecovery.
+func (t SpeedZoneThresholds) Zone(speedKmh float64) string {
+	switch {
+	case speedKmh >= t.Interval:
+		return ZoneInterval
+	case speedKmh >= t.Tempo:
+		return ZoneTempo
+	case speedKmh >= t.Easy:
+		return ZoneEasy
+	default:
+		return ZoneRecovery
+	}
+}
+
+// SpeedZone возвращает код зоны скорости бега speedKmh с границами SpeedZones.
+func SpeedZone(speedKmh float64) string {
+	return SpeedZones.Zone(speedKmh)
+}
@@ -0,0 +1,48 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestSpeedZone() {
+	tests := []struct {
+		name     string
+		speedKmh float64
+		want     string
+	}{
+		{name: "восстановительный бег", speedKmh: 6.825, want: ZoneRecovery},
+		{name: "граница лёгкого бега", speedKmh: 8, want: ZoneEasy},
+		{name: "лёгкий бег", speedKmh: 10.9, want: ZoneEasy},
+		{name: "темповый бег", speedKmh: 12, want: ZoneTempo},
+		{name: "граница интервального бега", speedKmh: 14, want: ZoneInterval},
+		{name: "интервальный бег", speedKmh: 22.75, want: ZoneInterval},
+		{name: "нулевая скорость", speedKmh: 0, want: ZoneRecovery},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, SpeedZone(tt.speedKmh))
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestSpeedZoneThresholds() {
+	thresholds := SpeedZoneThresholds{Easy: 6, Tempo: 9, Interval: 12}
+
+	assert.Equal(suite.T(), ZoneEasy, thresholds.Zone(6.825))
+	assert.Equal(suite.T(), ZoneInterval, thresholds.Zone(12))
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoSpeedZones() {
+	defer func(t SpeedZoneThresholds) { SpeedZones = t }(SpeedZones)
+
+	SpeedZones = SpeedZoneThresholds{Easy: 5, Tempo: 6, Interval: 20}
+
+	got, err := TrainingInfo("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Зона скорости: темповая\n")
+
+	got, err = TrainingInfo("6000,Ходьба,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.NotContains(suite.T(), got, "Зона скорости")
+}